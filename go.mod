@@ -1,3 +1,3 @@
 module github.com/nathangreene3/math
 
-go 1.13
+go 1.18
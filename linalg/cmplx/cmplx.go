@@ -0,0 +1,160 @@
+// Package cmplx provides the operations on complex matrices and vectors that
+// depend on conjugation or on the modulus of an entry. The types are
+// matrix.Of[complex128] and vector.Of[complex128], so arithmetic such as Add,
+// Copy, Multiply, Trace and Transpose is that of the generic types.
+package cmplx
+
+import (
+	gomath "math"
+	gocmplx "math/cmplx"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// Matrix is a matrix with complex128 entries.
+type Matrix = matrix.Of[complex128]
+
+// Vector is a vector with complex128 entries.
+type Vector = vector.Of[complex128]
+
+// ------------------------------------------------------------------------------
+// CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// FromReal returns a real matrix as a complex matrix.
+func FromReal(A matrix.Matrix) Matrix {
+	m, n := A.Dimensions()
+	return matrix.NewOf(m, n, func(i, j int) complex128 { return complex(A[i][j], 0) })
+}
+
+// VectorFromReal returns a real vector as a complex vector.
+func VectorFromReal(v vector.Vector) Vector {
+	return vector.NewOf(len(v), func(i int) complex128 { return complex(v[i], 0) })
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON MATRICES
+// ------------------------------------------------------------------------------
+
+// Approx returns true if A and B have the same dimensions and each entry of A
+// is within tol of the corresponding entry of B.
+func Approx(A, B Matrix, tol float64) bool {
+	if len(A) != len(B) {
+		return false
+	}
+
+	for i, r := range A {
+		if !ApproxVector(r, B[i], tol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Conjugate returns the matrix of the complex conjugates of the entries of A.
+func Conjugate(A Matrix) Matrix {
+	m, n := A.Dimensions()
+	return matrix.NewOf(m, n, func(i, j int) complex128 { return gocmplx.Conj(A[i][j]) })
+}
+
+// ConjugateTranspose returns the conjugate transpose A^H of A.
+func ConjugateTranspose(A Matrix) Matrix {
+	m, n := A.Dimensions()
+	return matrix.NewOf(n, m, func(i, j int) complex128 { return gocmplx.Conj(A[j][i]) })
+}
+
+// Determinant returns the determinant of a square matrix.
+func Determinant(A Matrix) complex128 {
+	return NewLU(A).Determinant()
+}
+
+// Inverse returns the inverse of a square matrix. If A is singular,
+// ErrSingular is returned.
+func Inverse(A Matrix) (Matrix, error) {
+	return NewLU(A).Inverse()
+}
+
+// IsHermitian returns true if A is square and each entry A[i][j] is within tol
+// of the conjugate of A[j][i].
+func IsHermitian(A Matrix, tol float64) bool {
+	m, n := A.Dimensions()
+	if m != n {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if tol < gocmplx.Abs(A[i][j]-gocmplx.Conj(A[j][i])) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsUnitary returns true if A is square and each entry of A^H A is within tol
+// of the corresponding entry of the identity.
+func IsUnitary(A Matrix, tol float64) bool {
+	m, n := A.Dimensions()
+	if m != n {
+		return false
+	}
+
+	return Approx(matrix.MultiplyOf(ConjugateTranspose(A), A), matrix.IdentityOf[complex128](n, n), tol)
+}
+
+// Solve Ax=y for x, for square matrix A. If A is singular, ErrSingular is
+// returned.
+func Solve(A Matrix, y Vector) (Vector, error) {
+	return NewLU(A).Solve(y)
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON VECTORS
+// ------------------------------------------------------------------------------
+
+// ApproxVector returns true if v and w have the same dimension and each entry
+// of v is within tol of the corresponding entry of w.
+func ApproxVector(v, w Vector, tol float64) bool {
+	if len(v) != len(w) {
+		return false
+	}
+
+	for i := range v {
+		if tol < gocmplx.Abs(v[i]-w[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Dot returns the inner product <v,w>, which is the sum of conj(v[i])*w[i]. It
+// is conjugate-linear in v and linear in w, so <v,v> is real and non-negative.
+// The method v.Dot(w) does not conjugate.
+func Dot(v, w Vector) complex128 {
+	if len(v) != len(w) {
+		panic("dimension mismatch")
+	}
+
+	var s complex128
+	for i := range v {
+		s += gocmplx.Conj(v[i]) * w[i]
+	}
+
+	return s
+}
+
+// Length returns the Euclidean norm of v, which is the square root of <v,v>. It
+// does not overflow unless the result does.
+func Length(v Vector) float64 {
+	var s float64
+	for _, z := range v {
+		s = gomath.Hypot(s, gocmplx.Abs(z))
+	}
+
+	return s
+}
@@ -0,0 +1,116 @@
+package cmplx
+
+import (
+	gomath "math"
+	gocmplx "math/cmplx"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// dft returns the n-by-n unitary discrete Fourier transform matrix.
+func dft(n int) Matrix {
+	return matrix.NewOf(n, n, func(i, j int) complex128 {
+		return gocmplx.Exp(complex(0, -2*gomath.Pi*float64(i*j)/float64(n))) / complex(gomath.Sqrt(float64(n)), 0)
+	})
+}
+
+func TestDot(t *testing.T) {
+	var (
+		v = Vector{1 + 2i, 3i}
+		w = Vector{2, 1 - 1i}
+	)
+
+	// conj(1+2i)*2 + conj(3i)*(1-i) = (2-4i) + (-3i-3)
+	if exp, rec := -1-7i, Dot(v, w); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := gocmplx.Conj(Dot(v, w)), Dot(w, v); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := complex(14, 0), Dot(v, v); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := gomath.Sqrt(14), Length(v); 1e-15 < gomath.Abs(exp-rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// The generic method does not conjugate: (1+2i)*2 + 3i*(1-i) = (2+4i) + (3i+3)
+	if exp, rec := 5+7i, v.Dot(w); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestHermitianAndUnitary(t *testing.T) {
+	tests := []struct {
+		A                  Matrix
+		hermitian, unitary bool
+	}{
+		{A: Matrix{Vector{0, 1}, Vector{1, 0}}, hermitian: true, unitary: true},    // Pauli X
+		{A: Matrix{Vector{0, -1i}, Vector{1i, 0}}, hermitian: true, unitary: true}, // Pauli Y
+		{A: Matrix{Vector{1, 1i}, Vector{1i, 1}}, hermitian: false, unitary: false},
+		{A: Matrix{Vector{2, 1 - 1i}, Vector{1 + 1i, 3}}, hermitian: true, unitary: false},
+		{A: dft(4), hermitian: false, unitary: true},
+		{A: Matrix{Vector{1, 0, 0}}, hermitian: false, unitary: false},
+	}
+
+	for _, test := range tests {
+		if rec := IsHermitian(test.A, 1e-12); test.hermitian != rec {
+			t.Fatalf("\nexpected IsHermitian(%v) = %v\nreceived %v", test.A, test.hermitian, rec)
+		}
+
+		if rec := IsUnitary(test.A, 1e-12); test.unitary != rec {
+			t.Fatalf("\nexpected IsUnitary(%v) = %v\nreceived %v", test.A, test.unitary, rec)
+		}
+	}
+
+	// The inverse of a unitary matrix is its conjugate transpose.
+	F := dft(5)
+	if rec, err := Inverse(F); err != nil || !Approx(rec, ConjugateTranspose(F), 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", ConjugateTranspose(F), rec, err)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	var (
+		A = Matrix{
+			Vector{1, 1i, 0},
+			Vector{-1i, 2, 1 + 1i},
+			Vector{0, 1 - 1i, 3},
+		}
+		x = Vector{1, 1i, 2 - 1i}
+	)
+
+	if rec, err := Solve(A, matrix.MultiplyVectorOf(A, x)); err != nil || !ApproxVector(rec, x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	inv, err := Inverse(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	I := matrix.IdentityOf[complex128](3, 3)
+	if rec := matrix.MultiplyOf(A, inv); !Approx(rec, I, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", I, rec)
+	}
+
+	if exp, rec := complex128(6), A.Trace(true); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// det(A) = 1(6-2) - i(-3i) = 4 - 3
+	if exp, rec := complex128(1), Determinant(A); 1e-12 < gocmplx.Abs(exp-rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// A real matrix has the same determinant either way.
+	B := matrix.Matrix{vector.Vector{2, 1, 0}, vector.Vector{1, 3, 1}, vector.Vector{0, 1, 4}}
+	if exp, rec := complex(B.Determinant(), 0), Determinant(FromReal(B)); 1e-12 < gocmplx.Abs(exp-rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
@@ -0,0 +1,159 @@
+package cmplx
+
+import (
+	gomath "math"
+	gocmplx "math/cmplx"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+)
+
+// epsilon is the machine epsilon for float64.
+const epsilon = 0x1p-52
+
+// ErrSingular is returned when a matrix has no inverse. It is the same error as
+// matrix.ErrSingular.
+var ErrSingular = matrix.ErrSingular
+
+// LU is the decomposition PA = LU of a square matrix A, where P is a
+// permutation matrix, L is unit lower triangular, and U is upper triangular.
+type LU struct {
+	lu       Matrix  // L below the diagonal (its unit diagonal is implied) and U on and above it
+	pivot    []int   // Row i of PA is row pivot[i] of A
+	sign     float64 // Sign of the permutation P
+	singular bool    // Indicates U has a negligible entry on its diagonal
+}
+
+// NewLU returns the LU decomposition of a square matrix A using Gaussian
+// elimination with partial pivoting on the modulus of each entry. A singular
+// matrix is still factored, but solving against it will return ErrSingular. A is
+// considered singular if the modulus of a pivot is no larger than n*eps*s, where
+// eps is the machine epsilon and s is the smaller of the largest moduli in the
+// pivot's row and column of A, as in matrix.NewLU.
+func NewLU(A Matrix) *LU {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	F := &LU{
+		lu:    A.Copy(),
+		pivot: make([]int, 0, n),
+		sign:  1,
+	}
+
+	rowMax, colMax := make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		F.pivot = append(F.pivot, i)
+		for j, a := range F.lu[i] {
+			a := gocmplx.Abs(a)
+			rowMax[i] = gomath.Max(rowMax[i], a)
+			colMax[j] = gomath.Max(colMax[j], a)
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if gocmplx.Abs(F.lu[p][k]) < gocmplx.Abs(F.lu[i][k]) {
+				p = i
+			}
+		}
+
+		if p != k {
+			F.lu[p], F.lu[k] = F.lu[k], F.lu[p]
+			F.pivot[p], F.pivot[k] = F.pivot[k], F.pivot[p]
+			F.sign = -F.sign
+		}
+
+		if gocmplx.Abs(F.lu[k][k]) <= float64(n)*epsilon*gomath.Min(rowMax[F.pivot[k]], colMax[k]) {
+			F.singular = true
+		}
+
+		if F.lu[k][k] == 0 {
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			F.lu[i][k] /= F.lu[k][k]
+			for j := k + 1; j < n; j++ {
+				F.lu[i][j] -= F.lu[i][k] * F.lu[k][j]
+			}
+		}
+	}
+
+	return F
+}
+
+// Determinant returns the determinant of the factored matrix.
+func (F *LU) Determinant() complex128 {
+	det := complex(F.sign, 0)
+	for i, r := range F.lu {
+		det *= r[i]
+	}
+
+	return det
+}
+
+// Inverse returns the inverse of the factored matrix.
+func (F *LU) Inverse() (Matrix, error) {
+	n := len(F.lu)
+	if F.singular {
+		return nil, ErrSingular
+	}
+
+	B := matrix.EmptyOf[complex128](n, n)
+	for j := 0; j < n; j++ {
+		e := make(Vector, n)
+		e[j] = 1
+		x, err := F.Solve(e)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < n; i++ {
+			B[i][j] = x[i]
+		}
+	}
+
+	return B, nil
+}
+
+// IsSingular returns true if the factored matrix has no inverse.
+func (F *LU) IsSingular() bool {
+	return F.singular
+}
+
+// Solve Ax=y for x.
+func (F *LU) Solve(y Vector) (Vector, error) {
+	n := len(F.lu)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	if F.singular {
+		return nil, ErrSingular
+	}
+
+	// Forward substitution solves Lz = Py, then back substitution solves
+	// Ux = z.
+	x := make(Vector, 0, n)
+	for i := 0; i < n; i++ {
+		x = append(x, y[F.pivot[i]])
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= F.lu[i][j] * x[j]
+		}
+	}
+
+	for i := n - 1; 0 <= i; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= F.lu[i][j] * x[j]
+		}
+
+		x[i] /= F.lu[i][i]
+	}
+
+	return x, nil
+}
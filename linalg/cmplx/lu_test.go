@@ -0,0 +1,60 @@
+package cmplx
+
+import (
+	gocmplx "math/cmplx"
+	"testing"
+)
+
+func TestLU(t *testing.T) {
+	// Rotation by a quarter turn has determinant 1, as does its complex
+	// diagonalization diag(i,-i).
+	tests := []struct {
+		A   Matrix
+		exp complex128
+	}{
+		{A: Matrix{Vector{0, -1}, Vector{1, 0}}, exp: 1},
+		{A: Matrix{Vector{1i, 0}, Vector{0, -1i}}, exp: 1},
+		{A: Matrix{Vector{1i, 2}, Vector{3, 4i}}, exp: -10},
+		{A: Matrix{Vector{1e20i, 0}, Vector{0, 1}}, exp: 1e20i}, // Badly scaled, but not singular
+	}
+
+	for _, test := range tests {
+		F := NewLU(test.A)
+		if F.IsSingular() {
+			t.Fatalf("\nexpected non-singular factorization of %v", test.A)
+		}
+
+		if rec := F.Determinant(); test.exp != rec {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+
+	singular := []Matrix{
+		{Vector{1, 1i}, Vector{1i, -1}},
+		{
+			// Singular, but rounding leaves a pivot near 1e-16
+			Vector{1 + 1i, 2 + 2i, 3 + 3i},
+			Vector{4 + 4i, 5 + 5i, 6 + 6i},
+			Vector{7 + 7i, 8 + 8i, 9 + 9i},
+		},
+	}
+
+	for _, A := range singular {
+		F := NewLU(A)
+		if !F.IsSingular() {
+			t.Fatalf("\nexpected singular factorization of %v", A)
+		}
+
+		if det := F.Determinant(); 1e-12 < gocmplx.Abs(det) {
+			t.Fatalf("\nexpected 0\nreceived %v", det)
+		}
+
+		if _, err := F.Solve(make(Vector, len(A))); err != ErrSingular {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+		}
+
+		if _, err := F.Inverse(); err != ErrSingular {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+package integer
+
+import (
+	"math/big"
+	"strings"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The normal forms follow A Course in Computational Algebraic Number Theory, by
+// Henri Cohen, sections 2.4.2 and 2.4.4. The determinant uses the fraction-free
+// elimination of Erwin H. Bareiss, "Sylvester's Identity and Multistep
+// Integer-Preserving Gaussian Elimination".
+// ------------------------------------------------------------------------------
+
+// BigMatrix is a matrix of arbitrary-precision integers.
+type BigMatrix [][]*big.Int
+
+// BigGenerator is a function defining the (i,j)th entry of a matrix.
+type BigGenerator func(i, j int) *big.Int
+
+// ------------------------------------------------------------------------------
+// BIG MATRIX CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// NewBig generates an m-by-n matrix with entries defined by a generating
+// function f. The returned entries are copies of those f returns.
+func NewBig(m, n int, f BigGenerator) BigMatrix {
+	A := make(BigMatrix, 0, m)
+	for i := 0; i < m; i++ {
+		r := make([]*big.Int, 0, n)
+		for j := 0; j < n; j++ {
+			r = append(r, new(big.Int).Set(f(i, j)))
+		}
+
+		A = append(A, r)
+	}
+
+	return A
+}
+
+// IdentityBig returns the m-by-n identity matrix.
+func IdentityBig(m, n int) BigMatrix {
+	zero, one := big.NewInt(0), big.NewInt(1)
+	return NewBig(m, n, func(i, j int) *big.Int {
+		if i == j {
+			return one
+		}
+		return zero
+	})
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON BIG MATRICES
+// ------------------------------------------------------------------------------
+
+// Copy returns a deep copied matrix.
+func (A BigMatrix) Copy() BigMatrix {
+	m, n := A.Dimensions()
+	return NewBig(m, n, func(i, j int) *big.Int { return A[i][j] })
+}
+
+// Determinant returns the determinant of a square matrix using Bareiss's
+// algorithm, in which every intermediate entry is itself a minor of A.
+func (A BigMatrix) Determinant() *big.Int {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("cannot take determinant of a non-square matrix")
+	}
+
+	var (
+		M    = A.Copy()
+		prev = big.NewInt(1)
+		neg  bool
+		t    = new(big.Int)
+	)
+
+	for k := 0; k < n-1; k++ {
+		if M[k][k].Sign() == 0 {
+			p := k + 1
+			for ; p < n && M[p][k].Sign() == 0; p++ {
+			}
+
+			if p == n {
+				return big.NewInt(0)
+			}
+
+			M[k], M[p] = M[p], M[k]
+			neg = !neg
+		}
+
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				M[i][j].Mul(M[i][j], M[k][k])
+				M[i][j].Sub(M[i][j], t.Mul(M[i][k], M[k][j]))
+				M[i][j].Quo(M[i][j], prev)
+			}
+		}
+
+		prev = M[k][k]
+	}
+
+	det := new(big.Int).Set(M[n-1][n-1])
+	if neg {
+		det.Neg(det)
+	}
+
+	return det
+}
+
+// Dimensions returns the dimensions (number of rows, number of columns) of a
+// matrix.
+func (A BigMatrix) Dimensions() (int, int) {
+	m, n := len(A), len(A[0])
+	for _, r := range A {
+		if n != len(r) {
+			panic("inconsistent matrix dimensions")
+		}
+	}
+
+	return m, n
+}
+
+// Equals returns true if two matrices are equal in dimension and for each
+// entry. Otherwise, it returns false.
+func (A BigMatrix) Equals(B BigMatrix) bool {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		return false
+	}
+
+	for i := 0; i < ma; i++ {
+		for j := 0; j < na; j++ {
+			if A[i][j].Cmp(B[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// HermiteNormalForm returns the row-style Hermite normal form H of A and a
+// unimodular matrix U such that UA = H. H is upper echelon, each pivot is
+// positive, and the entries above each pivot are non-negative and less than it.
+func (A BigMatrix) HermiteNormalForm() (BigMatrix, BigMatrix) {
+	var (
+		m, n = A.Dimensions()
+		H    = A.Copy()
+		U    = IdentityBig(m, m)
+		q    = new(big.Int)
+	)
+
+	for r, j := 0, 0; r < m && j < n; j++ {
+		// Fold the rest of column j into row r by extended gcd steps.
+		for i := r + 1; i < m; i++ {
+			if H[i][j].Sign() != 0 {
+				gcdRows(H, U, r, i, j)
+			}
+		}
+
+		if H[r][j].Sign() == 0 {
+			continue
+		}
+
+		if H[r][j].Sign() < 0 {
+			negateRow(H[r])
+			negateRow(U[r])
+		}
+
+		for k := 0; k < r; k++ {
+			q.Div(H[k][j], H[r][j]) // Euclidean division, so 0 <= H[k][j] - q*H[r][j]
+			subtractRow(H[k], q, H[r])
+			subtractRow(U[k], q, U[r])
+		}
+
+		r++
+	}
+
+	return H, U
+}
+
+// MultiplyBig multiplies several matrices.
+func MultiplyBig(As ...BigMatrix) BigMatrix {
+	switch len(As) {
+	case 0:
+		return nil
+	case 1:
+		return As[0]
+	}
+
+	B := As[0].multiply(As[1], nil)
+	for _, A := range As[2:] {
+		B = B.multiply(A, nil)
+	}
+
+	return B
+}
+
+// multiply returns AB, reduced modulo mod if it is not nil.
+func (A BigMatrix) multiply(B BigMatrix, mod *big.Int) BigMatrix {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	t := new(big.Int)
+	C := NewBig(ma, nb, func(i, j int) *big.Int { return t })
+	for i := 0; i < ma; i++ {
+		for j := 0; j < nb; j++ {
+			for k := 0; k < na; k++ {
+				C[i][j].Add(C[i][j], t.Mul(A[i][k], B[k][j]))
+			}
+
+			if mod != nil {
+				C[i][j].Mod(C[i][j], mod)
+			}
+		}
+	}
+
+	return C
+}
+
+// PowBig returns A^p, for square matrix A and non-negative p.
+func PowBig(A BigMatrix, p int) BigMatrix {
+	return powBig(A, p, nil)
+}
+
+// PowModBig returns A^p mod m, with each entry in [0,m), for square matrix A,
+// non-negative p, and positive m.
+func PowModBig(A BigMatrix, p int, m *big.Int) BigMatrix {
+	if m.Sign() <= 0 {
+		panic("modulus must be positive")
+	}
+
+	return powBig(A, p, m)
+}
+
+// powBig returns A^p, reduced modulo mod if it is not nil.
+func powBig(A BigMatrix, p int, mod *big.Int) BigMatrix {
+	m, n := A.Dimensions()
+	switch {
+	case m != n:
+		panic("matrix must be square")
+	case p < 0:
+		panic("power must be non-negative")
+	}
+
+	B, C := IdentityBig(m, n), A.Copy()
+	if mod != nil {
+		for i := range B {
+			for j := range B[i] {
+				B[i][j].Mod(B[i][j], mod)
+				C[i][j].Mod(C[i][j], mod)
+			}
+		}
+	}
+
+	for ; 0 < p; p >>= 1 {
+		if p&1 == 1 {
+			B = B.multiply(C, mod)
+		}
+
+		C = C.multiply(C, mod)
+	}
+
+	return B
+}
+
+// SmithNormalForm returns the Smith normal form D of A and unimodular matrices
+// U and V such that UAV = D. D is diagonal with non-negative entries, each of
+// which divides the next.
+func (A BigMatrix) SmithNormalForm() (BigMatrix, BigMatrix, BigMatrix) {
+	var (
+		m, n = A.Dimensions()
+		D    = A.Copy()
+		U    = IdentityBig(m, m)
+		V    = IdentityBig(n, n)
+		r    = new(big.Int)
+	)
+
+	for t := 0; t < m && t < n; t++ {
+		// Move the smallest non-zero entry of the remaining block to (t,t).
+		pi, pj := -1, -1
+		for i := t; i < m; i++ {
+			for j := t; j < n; j++ {
+				if D[i][j].Sign() != 0 && (pi < 0 || D[i][j].CmpAbs(D[pi][pj]) < 0) {
+					pi, pj = i, j
+				}
+			}
+		}
+
+		if pi < 0 {
+			break // The remaining block is zero.
+		}
+
+		D[t], D[pi] = D[pi], D[t]
+		U[t], U[pi] = U[pi], U[t]
+		swapColumns(D, t, pj)
+		swapColumns(V, t, pj)
+
+		for done := false; !done; {
+			for i := t + 1; i < m; i++ {
+				if D[i][t].Sign() != 0 {
+					gcdRows(D, U, t, i, t)
+				}
+			}
+
+			for j := t + 1; j < n; j++ {
+				if D[t][j].Sign() != 0 {
+					gcdColumns(D, V, t, j, t)
+				}
+			}
+
+			// Column operations may have refilled column t, so repeat until
+			// both row and column t are clear. Then d = D[t][t] must divide
+			// every remaining entry; if one is not divisible, adding its row
+			// to row t brings it into play and the gcd steps shrink d.
+			done = true
+			for i := t + 1; i < m && done; i++ {
+				if D[i][t].Sign() != 0 {
+					done = false
+				}
+			}
+
+			for i := t + 1; i < m && done; i++ {
+				for j := t + 1; j < n && done; j++ {
+					if r.Rem(D[i][j], D[t][t]).Sign() != 0 {
+						addRow(D[t], D[i])
+						addRow(U[t], U[i])
+						done = false
+					}
+				}
+			}
+		}
+
+		if D[t][t].Sign() < 0 {
+			negateRow(D[t])
+			negateRow(U[t])
+		}
+	}
+
+	return D, U, V
+}
+
+// String returns a formatted string representation of a matrix.
+func (A BigMatrix) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, r := range A {
+		if 0 < i {
+			sb.WriteByte(',')
+		}
+
+		sb.WriteByte('[')
+		for j, a := range r {
+			if 0 < j {
+				sb.WriteByte(' ')
+			}
+
+			sb.WriteString(a.String())
+		}
+
+		sb.WriteByte(']')
+	}
+
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// ------------------------------------------------------------------------------
+// UNIMODULAR ROW AND COLUMN OPERATIONS
+// ------------------------------------------------------------------------------
+
+// addRow adds v to u.
+func addRow(u, v []*big.Int) {
+	for k := range u {
+		u[k].Add(u[k], v[k])
+	}
+}
+
+// gcdColumns replaces columns r and i of A, for r < i, by unimodular
+// combinations of themselves such that A[k][r] becomes gcd(A[k][r], A[k][i])
+// and A[k][i] becomes zero, and applies the same operation to V.
+func gcdColumns(A, V BigMatrix, r, i, k int) {
+	a, b := new(big.Int).Set(A[k][r]), new(big.Int).Set(A[k][i])
+	x, y, c, d := combination(a, b)
+	combineColumns(A, r, i, x, y, c, d)
+	combineColumns(V, r, i, x, y, c, d)
+}
+
+// gcdRows replaces rows r and i of A, for r < i, by unimodular combinations of
+// themselves such that A[r][j] becomes gcd(A[r][j], A[i][j]) and A[i][j]
+// becomes zero, and applies the same operation to U.
+func gcdRows(A, U BigMatrix, r, i, j int) {
+	x, y, c, d := combination(A[r][j], A[i][j])
+	A[r], A[i] = combineRows(A[r], A[i], x, y, c, d)
+	U[r], U[i] = combineRows(U[r], U[i], x, y, c, d)
+}
+
+// combination returns x, y, c, d such that xa + yb = gcd(a,b), ca + db = 0,
+// and xd - yc = 1, so the operation (u,v) -> (xu+yv, cu+dv) is unimodular.
+func combination(a, b *big.Int) (*big.Int, *big.Int, *big.Int, *big.Int) {
+	var (
+		x, y = new(big.Int), new(big.Int)
+		g    = new(big.Int).GCD(x, y, new(big.Int).Abs(a), new(big.Int).Abs(b))
+	)
+
+	if a.Sign() < 0 {
+		x.Neg(x)
+	}
+
+	if b.Sign() < 0 {
+		y.Neg(y)
+	}
+
+	// c = -b/g and d = a/g give ca + db = 0 and xd - yc = (xa + yb)/g = 1.
+	c := new(big.Int).Quo(b, g)
+	c.Neg(c)
+	d := new(big.Int).Quo(a, g)
+	return x, y, c, d
+}
+
+// combineColumns replaces columns r and i of A by x*col_r + y*col_i and
+// c*col_r + d*col_i.
+func combineColumns(A BigMatrix, r, i int, x, y, c, d *big.Int) {
+	t := new(big.Int)
+	for _, row := range A {
+		u, v := row[r], row[i]
+		p := new(big.Int).Mul(x, u)
+		p.Add(p, t.Mul(y, v))
+		q := new(big.Int).Mul(c, u)
+		q.Add(q, t.Mul(d, v))
+		row[r], row[i] = p, q
+	}
+}
+
+// combineRows returns x*u + y*v and c*u + d*v.
+func combineRows(u, v []*big.Int, x, y, c, d *big.Int) ([]*big.Int, []*big.Int) {
+	var (
+		t = new(big.Int)
+		p = make([]*big.Int, 0, len(u))
+		q = make([]*big.Int, 0, len(u))
+	)
+
+	for k := range u {
+		a := new(big.Int).Mul(x, u[k])
+		p = append(p, a.Add(a, t.Mul(y, v[k])))
+		b := new(big.Int).Mul(c, u[k])
+		q = append(q, b.Add(b, t.Mul(d, v[k])))
+	}
+
+	return p, q
+}
+
+// negateRow negates each entry of u.
+func negateRow(u []*big.Int) {
+	for _, a := range u {
+		a.Neg(a)
+	}
+}
+
+// subtractRow subtracts q*v from u.
+func subtractRow(u []*big.Int, q *big.Int, v []*big.Int) {
+	t := new(big.Int)
+	for k := range u {
+		u[k].Sub(u[k], t.Mul(q, v[k]))
+	}
+}
+
+// swapColumns swaps columns i and j of A.
+func swapColumns(A BigMatrix, i, j int) {
+	for _, r := range A {
+		r[i], r[j] = r[j], r[i]
+	}
+}
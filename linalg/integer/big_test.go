@@ -0,0 +1,105 @@
+package integer
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestHermiteNormalForm(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for test := 0; test < 100; test++ {
+		var (
+			m, n = 1 + r.Intn(5), 1 + r.Intn(5)
+			A    = New(m, n, func(i, j int) int64 { return int64(r.Intn(21) - 10) })
+			H, U = A.Big().HermiteNormalForm()
+		)
+
+		if UA := MultiplyBig(U, A.Big()); !UA.Equals(H) {
+			t.Fatalf("\nexpected UA = %v\nreceived %v", H, UA)
+		}
+
+		if d := U.Determinant(); d.CmpAbs(big.NewInt(1)) != 0 {
+			t.Fatalf("\nexpected unimodular U\nreceived det(U) = %v", d)
+		}
+
+		// Each pivot is positive, the entries above it are reduced modulo
+		// it, and the entries below and to its left are zero.
+		row := 0
+		for j := 0; j < n && row < m; j++ {
+			if H[row][j].Sign() == 0 {
+				continue
+			}
+
+			if H[row][j].Sign() < 0 {
+				t.Fatalf("\nexpected positive pivot\nreceived %v", H)
+			}
+
+			for i := 0; i < row; i++ {
+				if H[i][j].Sign() < 0 || 0 <= H[i][j].Cmp(H[row][j]) {
+					t.Fatalf("\nexpected reduced entries above pivot\nreceived %v", H)
+				}
+			}
+
+			for i := row + 1; i < m; i++ {
+				if H[i][j].Sign() != 0 {
+					t.Fatalf("\nexpected zeroes below pivot\nreceived %v", H)
+				}
+			}
+
+			row++
+		}
+
+		for i := row; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if H[i][j].Sign() != 0 {
+					t.Fatalf("\nexpected zero rows after the last pivot\nreceived %v", H)
+				}
+			}
+		}
+	}
+}
+
+func TestSmithNormalForm(t *testing.T) {
+	A := Matrix{{2, 4, 4}, {-6, 6, 12}, {10, -4, -16}}
+	D, U, V := A.SmithNormalForm()
+	if exp := (Matrix{{2, 0, 0}, {0, 6, 0}, {0, 0, 12}}); !D.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, D)
+	}
+
+	if UAV := Multiply(U, A, V); !UAV.Equals(D) {
+		t.Fatalf("\nexpected %v\nreceived %v", D, UAV)
+	}
+
+	r := rand.New(rand.NewSource(0))
+	for test := 0; test < 100; test++ {
+		var (
+			m, n    = 1 + r.Intn(5), 1 + r.Intn(5)
+			A       = New(m, n, func(i, j int) int64 { return int64(r.Intn(21) - 10) })
+			D, U, V = A.Big().SmithNormalForm()
+		)
+
+		if UAV := MultiplyBig(U, A.Big(), V); !UAV.Equals(D) {
+			t.Fatalf("\nexpected UAV = %v\nreceived %v", D, UAV)
+		}
+
+		if du, dv := U.Determinant(), V.Determinant(); du.CmpAbs(big.NewInt(1)) != 0 || dv.CmpAbs(big.NewInt(1)) != 0 {
+			t.Fatalf("\nexpected unimodular U and V\nreceived det(U) = %v, det(V) = %v", du, dv)
+		}
+
+		for i := range D {
+			for j := range D[i] {
+				switch {
+				case i != j && D[i][j].Sign() != 0:
+					t.Fatalf("\nexpected diagonal matrix\nreceived %v", D)
+				case i == j && D[i][i].Sign() < 0:
+					t.Fatalf("\nexpected non-negative diagonal\nreceived %v", D)
+				case i == j && 0 < i && D[i-1][i-1].Sign() == 0 && D[i][i].Sign() != 0:
+					t.Fatalf("\nexpected zeroes last\nreceived %v", D)
+				case i == j && 0 < i && D[i-1][i-1].Sign() != 0 && new(big.Int).Rem(D[i][i], D[i-1][i-1]).Sign() != 0:
+					t.Fatalf("\nexpected each diagonal entry to divide the next\nreceived %v", D)
+				}
+			}
+		}
+	}
+}
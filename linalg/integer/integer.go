@@ -0,0 +1,289 @@
+package integer
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Matrix is a matrix of 64-bit integers. Operations that would overflow panic
+// rather than wrap around; use BigMatrix when entries may grow without bound.
+type Matrix [][]int64
+
+// Generator is a function defining the (i,j)th entry of a matrix.
+type Generator func(i, j int) int64
+
+// ------------------------------------------------------------------------------
+// MATRIX CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// New generates an m-by-n matrix with entries defined by a generating function
+// f.
+func New(m, n int, f Generator) Matrix {
+	A := make(Matrix, 0, m)
+	for i := 0; i < m; i++ {
+		r := make([]int64, 0, n)
+		for j := 0; j < n; j++ {
+			r = append(r, f(i, j))
+		}
+
+		A = append(A, r)
+	}
+
+	return A
+}
+
+// Identity returns the m-by-n identity matrix.
+func Identity(m, n int) Matrix {
+	return New(m, n, func(i, j int) int64 {
+		if i == j {
+			return 1
+		}
+		return 0
+	})
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON MATRICES
+// ------------------------------------------------------------------------------
+
+// Big returns A converted to arbitrary-precision entries.
+func (A Matrix) Big() BigMatrix {
+	m, n := A.Dimensions()
+	return NewBig(m, n, func(i, j int) *big.Int { return big.NewInt(A[i][j]) })
+}
+
+// Determinant returns the determinant of a square matrix using Bareiss's
+// algorithm. It panics if the determinant does not fit in an int64.
+func (A Matrix) Determinant() int64 {
+	det := A.Big().Determinant()
+	if !det.IsInt64() {
+		panic("integer overflow")
+	}
+
+	return det.Int64()
+}
+
+// Dimensions returns the dimensions (number of rows, number of columns) of a
+// matrix.
+func (A Matrix) Dimensions() (int, int) {
+	m, n := len(A), len(A[0])
+	for _, r := range A {
+		if n != len(r) {
+			panic("inconsistent matrix dimensions")
+		}
+	}
+
+	return m, n
+}
+
+// Equals returns true if two matrices are equal in dimension and for each
+// entry. Otherwise, it returns false.
+func (A Matrix) Equals(B Matrix) bool {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		return false
+	}
+
+	for i := 0; i < ma; i++ {
+		for j := 0; j < na; j++ {
+			if A[i][j] != B[i][j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// HermiteNormalForm returns the row-style Hermite normal form H of A and a
+// unimodular matrix U such that UA = H. It panics if an entry of H or U does not
+// fit in an int64.
+func (A Matrix) HermiteNormalForm() (Matrix, Matrix) {
+	H, U := A.Big().HermiteNormalForm()
+	return H.mustInt64(), U.mustInt64()
+}
+
+// Int64 returns A converted to 64-bit entries and whether every entry fit.
+func (A BigMatrix) Int64() (Matrix, bool) {
+	var (
+		m, n = A.Dimensions()
+		ok   = true
+	)
+
+	B := New(m, n, func(i, j int) int64 {
+		ok = ok && A[i][j].IsInt64()
+		return A[i][j].Int64()
+	})
+
+	return B, ok
+}
+
+// mustInt64 returns A converted to 64-bit entries, panicking if an entry does
+// not fit.
+func (A BigMatrix) mustInt64() Matrix {
+	B, ok := A.Int64()
+	if !ok {
+		panic("integer overflow")
+	}
+
+	return B
+}
+
+// multiply returns AB. It panics if an entry overflows.
+func (A Matrix) multiply(B Matrix) Matrix {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	return New(ma, nb, func(i, j int) int64 {
+		var v int64
+		for k := 0; k < na; k++ {
+			v = addInt64(v, mulInt64(A[i][k], B[k][j]))
+		}
+
+		return v
+	})
+}
+
+// multiplyMod returns AB mod m for matrices with entries in [0,m).
+func (A Matrix) multiplyMod(B Matrix, m uint64) Matrix {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	return New(ma, nb, func(i, j int) int64 {
+		var v uint64
+		for k := 0; k < na; k++ {
+			// The full 128-bit product is reduced, so nothing overflows.
+			// As v and the product are each less than m <= 2^63, their sum
+			// fits in a uint64.
+			hi, lo := bits.Mul64(uint64(A[i][k]), uint64(B[k][j]))
+			if v += bits.Rem64(hi, lo, m); m <= v {
+				v -= m
+			}
+		}
+
+		return int64(v)
+	})
+}
+
+// Multiply several matrices. It panics if an entry overflows.
+func Multiply(As ...Matrix) Matrix {
+	switch len(As) {
+	case 0:
+		return nil
+	case 1:
+		return As[0]
+	}
+
+	B := As[0].multiply(As[1])
+	for _, A := range As[2:] {
+		B = B.multiply(A)
+	}
+
+	return B
+}
+
+// Pow returns A^p, for square matrix A and non-negative p. It panics if an
+// entry overflows.
+func Pow(A Matrix, p int) Matrix {
+	m, n := A.Dimensions()
+	switch {
+	case m != n:
+		panic("matrix must be square")
+	case p < 0:
+		panic("power must be non-negative")
+	}
+
+	// The last squaring is skipped, as it may overflow even though the
+	// result does not.
+	B, C := Identity(m, n), A
+	for ; 0 < p; p >>= 1 {
+		if p&1 == 1 {
+			B = B.multiply(C)
+		}
+
+		if 1 < p {
+			C = C.multiply(C)
+		}
+	}
+
+	return B
+}
+
+// PowMod returns A^p mod m, with each entry in [0,m), for square matrix A,
+// non-negative p, and positive m.
+func PowMod(A Matrix, p int, m int64) Matrix {
+	r, c := A.Dimensions()
+	switch {
+	case r != c:
+		panic("matrix must be square")
+	case p < 0:
+		panic("power must be non-negative")
+	case m <= 0:
+		panic("modulus must be positive")
+	}
+
+	mod := func(a int64) int64 {
+		if a %= m; a < 0 {
+			a += m
+		}
+		return a
+	}
+
+	B := New(r, c, func(i, j int) int64 {
+		if i == j {
+			return mod(1)
+		}
+		return 0
+	})
+
+	C := New(r, c, func(i, j int) int64 { return mod(A[i][j]) })
+	for ; 0 < p; p >>= 1 {
+		if p&1 == 1 {
+			B = B.multiplyMod(C, uint64(m))
+		}
+
+		C = C.multiplyMod(C, uint64(m))
+	}
+
+	return B
+}
+
+// SmithNormalForm returns the Smith normal form D of A and unimodular matrices U
+// and V such that UAV = D. It panics if an entry of D, U, or V does not fit in
+// an int64.
+func (A Matrix) SmithNormalForm() (Matrix, Matrix, Matrix) {
+	D, U, V := A.Big().SmithNormalForm()
+	return D.mustInt64(), U.mustInt64(), V.mustInt64()
+}
+
+// addInt64 returns a+b, panicking on overflow.
+func addInt64(a, b int64) int64 {
+	c := a + b
+	if (0 < b && c < a) || (b < 0 && a < c) {
+		panic("integer overflow")
+	}
+
+	return c
+}
+
+// mulInt64 returns ab, panicking on overflow.
+func mulInt64(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		panic("integer overflow")
+	}
+
+	return c
+}
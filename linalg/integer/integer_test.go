@@ -0,0 +1,74 @@
+package integer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nathangreene3/math"
+)
+
+// fibonacci is the matrix [0 1; 1 1], whose nth power has the nth Fibonacci
+// term as its bottom-right entry.
+var fibonacci = Matrix{{0, 1}, {1, 1}}
+
+func TestPow(t *testing.T) {
+	// Fibonacci(91) is the largest term that fits in an int64.
+	for n := 0; n <= 91; n++ {
+		if exp, rec := int64(math.Fibonacci(n)), Pow(fibonacci, n)[1][1]; exp != rec {
+			t.Fatalf("\nexpected %d\nreceived %d", exp, rec)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("\nexpected overflow to panic")
+		}
+	}()
+
+	Pow(fibonacci, 92)
+}
+
+func TestPowMod(t *testing.T) {
+	var (
+		p   = 1000
+		exp = PowBig(fibonacci.Big(), p)
+	)
+
+	for _, m := range []int64{2, 10, 1000000007, 1<<62 + 135} {
+		rec := PowMod(fibonacci, p, m)
+		for i := range rec {
+			for j := range rec[i] {
+				if e := new(big.Int).Mod(exp[i][j], big.NewInt(m)); !e.IsInt64() || e.Int64() != rec[i][j] {
+					t.Fatalf("\nexpected %v\nreceived %d", e, rec[i][j])
+				}
+			}
+		}
+
+		if recBig, ok := PowModBig(fibonacci.Big(), p, big.NewInt(m)).Int64(); !ok || !recBig.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", rec, recBig)
+		}
+	}
+
+	if rec := PowMod(Matrix{{-1}}, 3, 5); rec[0][0] != 4 {
+		t.Fatalf("\nexpected 4\nreceived %d", rec[0][0])
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	tests := []struct {
+		A   Matrix
+		exp int64
+	}{
+		{A: Matrix{{3}}, exp: 3},
+		{A: Matrix{{0, 1}, {1, 0}}, exp: -1},
+		{A: Matrix{{2, 0, 1}, {1, 3, 2}, {1, 1, 2}}, exp: 6},
+		{A: Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, exp: 0},
+		{A: Matrix{{0, 2, 1, 3}, {0, 0, 4, 1}, {5, 1, 0, 2}, {1, 0, 0, 1}}, exp: 35},
+	}
+
+	for _, test := range tests {
+		if rec := test.A.Determinant(); rec != test.exp {
+			t.Fatalf("\nexpected %d\nreceived %d", test.exp, rec)
+		}
+	}
+}
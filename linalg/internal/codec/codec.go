@@ -0,0 +1,32 @@
+// Package codec reads and writes dense arrays of float64 values in the
+// MatrixMarket and NumPy .npy formats. Arrays are passed as a shape and their
+// entries in row-major order, which is how both the matrix and vector packages
+// encode themselves.
+package codec
+
+import (
+	"errors"
+	gomath "math"
+)
+
+// ErrFormat is returned when the input is not in the expected format.
+var ErrFormat = errors.New("malformed input")
+
+// ErrUnsupported is returned when the input is well-formed but uses a feature
+// that is not supported, such as complex entries.
+var ErrUnsupported = errors.New("unsupported format")
+
+// size returns the number of entries in an array of a given shape. It returns
+// false if the number overflows an int.
+func size(shape ...int) (int, bool) {
+	n := 1
+	for _, d := range shape {
+		if d != 0 && gomath.MaxInt/d < n {
+			return 0, false
+		}
+
+		n *= d
+	}
+
+	return n, true
+}
@@ -0,0 +1,191 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	gomath "math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// npyFile returns an npy file, version 1.0, with a given header and data.
+func npyFile(header string, data interface{}, order binary.ByteOrder) []byte {
+	var b bytes.Buffer
+	b.WriteString(npyMagic)
+	b.Write([]byte{1, 0})
+	binary.Write(&b, binary.LittleEndian, uint16(len(header)))
+	b.WriteString(header)
+	binary.Write(&b, order, data)
+	return b.Bytes()
+}
+
+func TestNPY(t *testing.T) {
+	tests := []struct {
+		shape []int
+		data  []float64
+	}{
+		{shape: []int{2, 3}, data: []float64{1, -2, 3.5, gomath.Inf(1), gomath.Copysign(0, -1), 1e-310}},
+		{shape: []int{4}, data: []float64{0.1, 0.2, 0.3, gomath.MaxFloat64}},
+		{shape: []int{3, 0}, data: []float64{}},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := WriteNPY(&b, test.shape, test.data); err != nil {
+			t.Fatal(err)
+		}
+
+		if headerEnd := len(npyMagic) + 4 + int(binary.LittleEndian.Uint16(b.Bytes()[8:])); headerEnd%npyAlign != 0 {
+			t.Fatalf("\nexpected data aligned to %d bytes\nreceived offset %d", npyAlign, headerEnd)
+		}
+
+		shape, data, err := ReadNPY(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(test.shape, shape) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.shape, shape)
+		}
+
+		for i := range data {
+			if gomath.Float64bits(test.data[i]) != gomath.Float64bits(data[i]) {
+				t.Fatalf("\nexpected %v\nreceived %v", test.data, data)
+			}
+		}
+	}
+}
+
+func TestReadNPY(t *testing.T) {
+	var (
+		exp   = []float64{1, 2, 3, 4, 5, 6}
+		tests = [][]byte{
+			npyFile("{'descr': '>f8', 'fortran_order': False, 'shape': (2, 3), }\n", []float64{1, 2, 3, 4, 5, 6}, binary.BigEndian),
+			npyFile("{'descr': '<f8', 'fortran_order': True, 'shape': (2, 3), }\n", []float64{1, 4, 2, 5, 3, 6}, binary.LittleEndian),
+			npyFile("{'descr': '<i8', 'fortran_order': False, 'shape': (2, 3), }\n", []int64{1, 2, 3, 4, 5, 6}, binary.LittleEndian),
+			npyFile("{'descr': '<f4', 'fortran_order': False, 'shape': (2, 3), }\n", []float32{1, 2, 3, 4, 5, 6}, binary.LittleEndian),
+			npyFile("{'descr': '<i4', 'fortran_order': True, 'shape': (2, 3), }\n", []int32{1, 4, 2, 5, 3, 6}, binary.LittleEndian),
+		}
+	)
+
+	for _, test := range tests {
+		shape, data, err := ReadNPY(bytes.NewReader(test))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual([]int{2, 3}, shape) || !reflect.DeepEqual(exp, data) {
+			t.Fatalf("\nexpected %v %v\nreceived %v %v", []int{2, 3}, exp, shape, data)
+		}
+	}
+
+	complexFile := npyFile("{'descr': '<c16', 'fortran_order': False, 'shape': (1,), }\n", []float64{1, 0}, binary.LittleEndian)
+	if _, _, err := ReadNPY(bytes.NewReader(complexFile)); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrUnsupported, err)
+	}
+
+	if _, _, err := ReadNPY(strings.NewReader("not an npy file")); !errors.Is(err, ErrFormat) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrFormat, err)
+	}
+
+	for _, shape := range []string{
+		"(2, 3)",                   // Truncated
+		"(1000000000000,)",         // Oversized
+		"(4611686018427387904, 4)", // Overflowing
+		"(1152921504606846976, 2)", // Overflowing bytes, but not entries
+	} {
+		file := npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': "+shape+", }\n", []float64{1, 2}, binary.LittleEndian)
+		if _, _, err := ReadNPY(bytes.NewReader(file)); !errors.Is(err, ErrFormat) {
+			t.Fatalf("\nexpected %v\nreceived %v for shape %s", ErrFormat, err, shape)
+		}
+	}
+}
+
+func TestMatrixMarket(t *testing.T) {
+	data := []float64{1, 0, -2.5, 0, 1e100, 0.1}
+	for _, coordinate := range []bool{false, true} {
+		var b bytes.Buffer
+		if err := WriteMatrixMarket(&b, 2, 3, data, coordinate); err != nil {
+			t.Fatal(err)
+		}
+
+		m, n, rec, err := ReadMatrixMarket(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m != 2 || n != 3 || !reflect.DeepEqual(data, rec) {
+			t.Fatalf("\nexpected 2 3 %v\nreceived %d %d %v", data, m, n, rec)
+		}
+	}
+}
+
+func TestReadMatrixMarket(t *testing.T) {
+	tests := []struct {
+		input string
+		exp   []float64
+	}{
+		{
+			input: "%%MatrixMarket matrix array real symmetric\n% comment\n2 2\n1\n2\n3\n",
+			exp:   []float64{1, 2, 2, 3},
+		},
+		{
+			input: "%%MatrixMarket matrix array real skew-symmetric\n2 2\n5\n",
+			exp:   []float64{0, -5, 5, 0},
+		},
+		{
+			input: "%%MatrixMarket matrix coordinate integer symmetric\n2 2 2\n1 1 4\n2 1 -1\n",
+			exp:   []float64{4, -1, -1, 0},
+		},
+		{
+			input: "%%MatrixMarket matrix coordinate pattern general\n2 2 2\n1 2\n2 1\n\n",
+			exp:   []float64{0, 1, 1, 0},
+		},
+		{
+			input: "%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1.5\n1 1 2\n",
+			exp:   []float64{3.5, 0, 0, 0},
+		},
+	}
+
+	for _, test := range tests {
+		_, _, rec, err := ReadMatrixMarket(strings.NewReader(test.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(test.exp, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+
+	// A short coordinate input may still describe a matrix of 2^20 entries.
+	if _, _, rec, err := ReadMatrixMarket(strings.NewReader("%%MatrixMarket matrix coordinate real general\n1024 1024 1\n1 1 1\n")); err != nil || len(rec) != 1<<20 {
+		t.Fatalf("\nexpected %d entries\nreceived %d (%v)", 1<<20, len(rec), err)
+	}
+
+	errTests := []struct {
+		input string
+		exp   error
+	}{
+		{input: "2 2\n1\n2\n3\n4\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix array complex general\n1 1\n1 0\n", exp: ErrUnsupported},
+		{input: "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix array real general\n2 2\n1\n2\n3\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix array real general\n100000 100000\n1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix array real symmetric\n1000 1000\n1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix array real general\n4611686018427387904 4\n1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix coordinate real general\n4611686018427387904 4 1\n1 1 1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix coordinate real general\n100000 100000 1\n1 1 1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix coordinate real general\n11585 11585 0\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix coordinate real general\n1025 1024 1\n1 1 1\n", exp: ErrFormat},
+		{input: "%%MatrixMarket matrix coordinate real general\n2 2 1000000000000\n1 1 1\n", exp: ErrFormat},
+	}
+
+	for _, test := range errTests {
+		if _, _, _, err := ReadMatrixMarket(strings.NewReader(test.input)); !errors.Is(err, test.exp) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, err)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	gomath "math"
+	"strconv"
+	"strings"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The MatrixMarket exchange formats are specified at
+// https://math.nist.gov/MatrixMarket/formats.html
+// ------------------------------------------------------------------------------
+
+const (
+	mmBanner = "%%MatrixMarket"
+
+	// A matrix in the coordinate format may list far fewer entries than it
+	// has, so its dense result is bounded by the length of the input instead:
+	// it may have mmDenseFloor entries, or mmDensePerByte entries for each
+	// byte of input if that is more. The result then takes no more than 64
+	// bytes for each byte read.
+	mmDenseFloor   = 1 << 20
+	mmDensePerByte = 8
+)
+
+// WriteMatrixMarket writes an m-by-n array in the MatrixMarket format. The
+// array format lists every entry in column-major order. The coordinate format
+// lists only the non-zero entries, in row-major order.
+func WriteMatrixMarket(w io.Writer, m, n int, data []float64, coordinate bool) error {
+	if k, ok := size(m, n); !ok || k != len(data) {
+		panic("dimension mismatch")
+	}
+
+	bw := bufio.NewWriter(w)
+	if coordinate {
+		var nnz int
+		// Negative zero is listed so that it survives a round trip.
+		for _, x := range data {
+			if x != 0 || gomath.Signbit(x) {
+				nnz++
+			}
+		}
+
+		fmt.Fprintf(bw, "%s matrix coordinate real general\n%d %d %d\n", mmBanner, m, n, nnz)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if x := data[i*n+j]; x != 0 || gomath.Signbit(x) {
+					fmt.Fprintf(bw, "%d %d %s\n", i+1, j+1, strconv.FormatFloat(x, 'g', -1, 64))
+				}
+			}
+		}
+	} else {
+		fmt.Fprintf(bw, "%s matrix array real general\n%d %d\n", mmBanner, m, n)
+		for j := 0; j < n; j++ {
+			for i := 0; i < m; i++ {
+				bw.WriteString(strconv.FormatFloat(data[i*n+j], 'g', -1, 64))
+				bw.WriteByte('\n')
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ReadMatrixMarket reads a real, integer or pattern matrix in the MatrixMarket
+// array or coordinate format and returns its dimensions and entries in row-major
+// order. General, symmetric and skew-symmetric matrices are accepted. Duplicate
+// coordinate entries are summed. ErrFormat is returned if the declared size is
+// more than the input can hold. A coordinate matrix may have at most 2^20
+// entries, or eight for each byte of input if that is more.
+func ReadMatrixMarket(r io.Reader) (int, int, []float64, error) {
+	// The whole input is read first, so the dimensions it declares can be
+	// checked against its length before anything is allocated for them.
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	var (
+		sc     = bufio.NewScanner(bytes.NewReader(input))
+		fields []string
+	)
+
+	// next sets fields to those of the next line that is neither blank nor a
+	// comment and returns false if there is no such line.
+	next := func() bool {
+		for sc.Scan() {
+			line := strings.TrimSpace(sc.Text())
+			if line != "" && line[0] != '%' {
+				fields = strings.Fields(line)
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return 0, 0, nil, err
+		}
+
+		return 0, 0, nil, fmt.Errorf("matrix market banner not found: %w", ErrFormat)
+	}
+
+	banner := strings.Fields(strings.ToLower(sc.Text()))
+	if len(banner) != 5 || banner[0] != strings.ToLower(mmBanner) {
+		return 0, 0, nil, fmt.Errorf("matrix market banner not found: %w", ErrFormat)
+	}
+
+	var (
+		object, format, field, symmetry = banner[1], banner[2], banner[3], banner[4]
+		coordinate                      = format == "coordinate"
+	)
+
+	switch {
+	case object != "matrix":
+		return 0, 0, nil, fmt.Errorf("matrix market object %q: %w", object, ErrUnsupported)
+	case !coordinate && format != "array":
+		return 0, 0, nil, fmt.Errorf("matrix market format %q: %w", format, ErrUnsupported)
+	case field != "real" && field != "double" && field != "integer" && (field != "pattern" || !coordinate):
+		return 0, 0, nil, fmt.Errorf("matrix market field %q: %w", field, ErrUnsupported)
+	case symmetry != "general" && symmetry != "symmetric" && symmetry != "skew-symmetric":
+		return 0, 0, nil, fmt.Errorf("matrix market symmetry %q: %w", symmetry, ErrUnsupported)
+	}
+
+	if !next() {
+		return 0, 0, nil, fmt.Errorf("matrix market size line not found: %w", ErrFormat)
+	}
+
+	dims := make([]int, 0, 3)
+	for _, f := range fields {
+		d, err := strconv.Atoi(f)
+		if err != nil || d < 0 {
+			return 0, 0, nil, fmt.Errorf("matrix market size %q: %w", f, ErrFormat)
+		}
+
+		dims = append(dims, d)
+	}
+
+	if coordinate && len(dims) != 3 || !coordinate && len(dims) != 2 {
+		return 0, 0, nil, fmt.Errorf("matrix market size line has %d values: %w", len(dims), ErrFormat)
+	}
+
+	m, n := dims[0], dims[1]
+	if symmetry != "general" && m != n {
+		return 0, 0, nil, fmt.Errorf("matrix market %s matrix is not square: %w", symmetry, ErrFormat)
+	}
+
+	// Each listed entry takes at least one byte of input. Arrays list every
+	// entry of the stored triangle. The coordinate format lists only some, so
+	// the dense result is instead bounded as described at mmDenseFloor.
+	entries, ok := size(m, n)
+	listed := entries
+	switch {
+	case coordinate:
+		listed = dims[2]
+	case symmetry == "symmetric":
+		listed = entries/2 + n/2 + n%2
+	case symmetry == "skew-symmetric":
+		listed = entries/2 - n/2
+	}
+
+	dense := mmDenseFloor
+	if dense < mmDensePerByte*len(input) {
+		dense = mmDensePerByte * len(input)
+	}
+
+	if !ok || len(input) < listed || coordinate && dense < entries {
+		return 0, 0, nil, fmt.Errorf("matrix market size %v is too large for the input: %w", dims, ErrFormat)
+	}
+
+	data := make([]float64, entries)
+
+	// add adds x to the k-th entry. Zero entries are replaced rather than
+	// added to, which preserves the sign of negative zero.
+	add := func(k int, x float64) {
+		if data[k] == 0 {
+			data[k] = x
+		} else {
+			data[k] += x
+		}
+	}
+
+	// set adds entry (i,j) and its reflection, if any.
+	set := func(i, j int, x float64) {
+		add(i*n+j, x)
+		switch {
+		case i == j:
+		case symmetry == "symmetric":
+			add(j*n+i, x)
+		case symmetry == "skew-symmetric":
+			add(j*n+i, -x)
+		}
+	}
+
+	parse := func(s string) (float64, error) {
+		x, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("matrix market entry %q: %w", s, ErrFormat)
+		}
+
+		return x, nil
+	}
+
+	if coordinate {
+		width := 3
+		if field == "pattern" {
+			width = 2
+		}
+
+		for k := 0; k < dims[2]; k++ {
+			if !next() || len(fields) != width {
+				return 0, 0, nil, fmt.Errorf("matrix market entry %d is missing or malformed: %w", k+1, ErrFormat)
+			}
+
+			i, err1 := strconv.Atoi(fields[0])
+			j, err2 := strconv.Atoi(fields[1])
+			if err1 != nil || err2 != nil || i < 1 || m < i || j < 1 || n < j {
+				return 0, 0, nil, fmt.Errorf("matrix market entry %d has invalid index: %w", k+1, ErrFormat)
+			}
+
+			x := 1.0
+			if field != "pattern" {
+				var err error
+				if x, err = parse(fields[2]); err != nil {
+					return 0, 0, nil, err
+				}
+			}
+
+			set(i-1, j-1, x)
+		}
+	} else {
+		// Symmetric arrays list only the lower triangle and skew-symmetric
+		// arrays only the strict lower triangle, column by column.
+		for j := 0; j < n; j++ {
+			i0 := 0
+			switch symmetry {
+			case "symmetric":
+				i0 = j
+			case "skew-symmetric":
+				i0 = j + 1
+			}
+
+			for i := i0; i < m; i++ {
+				if !next() || len(fields) != 1 {
+					return 0, 0, nil, fmt.Errorf("matrix market entry (%d,%d) is missing or malformed: %w", i+1, j+1, ErrFormat)
+				}
+
+				x, err := parse(fields[0])
+				if err != nil {
+					return 0, 0, nil, err
+				}
+
+				set(i, j, x)
+			}
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return 0, 0, nil, err
+	}
+
+	return m, n, data, nil
+}
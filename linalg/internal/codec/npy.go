@@ -0,0 +1,275 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	gomath "math"
+	"strconv"
+	"strings"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The .npy format is specified at
+// https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+// ------------------------------------------------------------------------------
+
+const (
+	npyMagic = "\x93NUMPY"
+
+	// npyAlign is the alignment of the data following the header.
+	npyAlign = 64
+)
+
+// WriteNPY writes an array of a given shape in the .npy format, version 1.0,
+// as little-endian float64 values in row-major order.
+func WriteNPY(w io.Writer, shape []int, data []float64) error {
+	if n, ok := size(shape...); !ok || n != len(data) {
+		panic("dimension mismatch")
+	}
+
+	dims := make([]string, 0, len(shape))
+	for _, d := range shape {
+		dims = append(dims, strconv.Itoa(d))
+	}
+
+	// A tuple of one value requires a trailing comma.
+	tuple := strings.Join(dims, ", ")
+	if len(dims) == 1 {
+		tuple += ","
+	}
+
+	var (
+		header = "{'descr': '<f8', 'fortran_order': False, 'shape': (" + tuple + "), }"
+		pad    = npyAlign - (len(npyMagic)+4+len(header)+1)%npyAlign
+	)
+
+	header += strings.Repeat(" ", pad%npyAlign) + "\n"
+	if gomath.MaxUint16 < len(header) {
+		return fmt.Errorf("npy header too long: %w", ErrUnsupported)
+	}
+
+	b := make([]byte, 0, len(npyMagic)+4+len(header)+8*len(data))
+	b = append(b, npyMagic...)
+	b = append(b, 1, 0)
+	b = append(b, byte(len(header)), byte(len(header)>>8))
+	b = append(b, header...)
+	for _, x := range data {
+		b = append(b, make([]byte, 8)...)
+		binary.LittleEndian.PutUint64(b[len(b)-8:], gomath.Float64bits(x))
+	}
+
+	_, err := w.Write(b)
+	return err
+}
+
+// ReadNPY reads an array in the .npy format and returns its shape and entries
+// in row-major order. Arrays of 4- and 8-byte floats and integers in either
+// byte order and either storage order are accepted. ErrFormat is returned if the
+// declared shape is more than the input holds.
+func ReadNPY(r io.Reader) ([]int, []float64, error) {
+	prefix := make([]byte, len(npyMagic)+2)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, nil, err
+	}
+
+	if string(prefix[:len(npyMagic)]) != npyMagic {
+		return nil, nil, fmt.Errorf("npy magic string not found: %w", ErrFormat)
+	}
+
+	var headerLen int
+	switch major := prefix[len(npyMagic)]; major {
+	case 1:
+		b := make([]byte, 2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, nil, err
+		}
+
+		headerLen = int(binary.LittleEndian.Uint16(b))
+	case 2, 3:
+		b := make([]byte, 4)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, nil, err
+		}
+
+		headerLen = int(binary.LittleEndian.Uint32(b))
+	default:
+		return nil, nil, fmt.Errorf("npy version %d: %w", major, ErrUnsupported)
+	}
+
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, nil, err
+	}
+
+	descr, fortran, shape, err := parseNPYHeader(string(header))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(descr) != 3 {
+		return nil, nil, fmt.Errorf("npy dtype %q: %w", descr, ErrUnsupported)
+	}
+
+	var order binary.ByteOrder
+	switch descr[0] {
+	case '<':
+		order = binary.LittleEndian
+	case '>':
+		order = binary.BigEndian
+	default:
+		return nil, nil, fmt.Errorf("npy dtype %q: %w", descr, ErrUnsupported)
+	}
+
+	var decode func([]byte) float64
+	switch descr[1:] {
+	case "f8":
+		decode = func(b []byte) float64 { return gomath.Float64frombits(order.Uint64(b)) }
+	case "f4":
+		decode = func(b []byte) float64 { return float64(gomath.Float32frombits(order.Uint32(b))) }
+	case "i8":
+		decode = func(b []byte) float64 { return float64(int64(order.Uint64(b))) }
+	case "i4":
+		decode = func(b []byte) float64 { return float64(int32(order.Uint32(b))) }
+	default:
+		return nil, nil, fmt.Errorf("npy dtype %q: %w", descr, ErrUnsupported)
+	}
+
+	width := int(descr[2] - '0')
+	n, ok := size(shape...)
+	if !ok || gomath.MaxInt/width < n {
+		return nil, nil, fmt.Errorf("npy shape %v is too large: %w", shape, ErrFormat)
+	}
+
+	// The shape is untrusted, so the data is read without allocating more
+	// than the input holds.
+	raw, err := io.ReadAll(io.LimitReader(r, int64(width*n)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(raw) < width*n {
+		return nil, nil, fmt.Errorf("npy data has %d bytes, but shape %v requires %d: %w", len(raw), shape, width*n, ErrFormat)
+	}
+
+	data := make([]float64, 0, n)
+	for i := 0; i < n; i++ {
+		data = append(data, decode(raw[i*width:(i+1)*width]))
+	}
+
+	if fortran && 1 < len(shape) {
+		data = toRowMajor(shape, data)
+	}
+
+	return shape, data, nil
+}
+
+// parseNPYHeader returns the dtype, storage order and shape described by an npy
+// header, which is a Python dictionary literal.
+func parseNPYHeader(header string) (string, bool, []int, error) {
+	value := func(key string) (string, error) {
+		i := strings.Index(header, "'"+key+"'")
+		if i < 0 {
+			return "", fmt.Errorf("npy header missing %q: %w", key, ErrFormat)
+		}
+
+		s := strings.TrimLeft(header[i+len(key)+2:], " ")
+		if !strings.HasPrefix(s, ":") {
+			return "", fmt.Errorf("npy header missing value for %q: %w", key, ErrFormat)
+		}
+
+		return strings.TrimLeft(s[1:], " "), nil
+	}
+
+	s, err := value("descr")
+	if err != nil {
+		return "", false, nil, err
+	}
+
+	if len(s) == 0 || (s[0] != '\'' && s[0] != '"') {
+		return "", false, nil, fmt.Errorf("npy descr is not a string: %w", ErrUnsupported)
+	}
+
+	end := strings.IndexByte(s[1:], s[0])
+	if end < 0 {
+		return "", false, nil, fmt.Errorf("npy descr is unterminated: %w", ErrFormat)
+	}
+
+	descr := s[1 : end+1]
+	if s, err = value("fortran_order"); err != nil {
+		return "", false, nil, err
+	}
+
+	var fortran bool
+	switch {
+	case strings.HasPrefix(s, "True"):
+		fortran = true
+	case strings.HasPrefix(s, "False"):
+	default:
+		return "", false, nil, fmt.Errorf("npy fortran_order is not a boolean: %w", ErrFormat)
+	}
+
+	if s, err = value("shape"); err != nil {
+		return "", false, nil, err
+	}
+
+	end = strings.IndexByte(s, ')')
+	if !strings.HasPrefix(s, "(") || end < 0 {
+		return "", false, nil, fmt.Errorf("npy shape is not a tuple: %w", ErrFormat)
+	}
+
+	shape := make([]int, 0, 2)
+	for _, d := range strings.Split(s[1:end], ",") {
+		if d = strings.TrimSpace(d); d == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(d)
+		if err != nil || n < 0 {
+			return "", false, nil, fmt.Errorf("npy shape has invalid dimension %q: %w", d, ErrFormat)
+		}
+
+		shape = append(shape, n)
+	}
+
+	return descr, fortran, shape, nil
+}
+
+// toRowMajor returns the entries of an array stored in column-major order in
+// row-major order.
+func toRowMajor(shape []int, data []float64) []float64 {
+	// Walk the indices in row-major order, looking up the column-major offset
+	// of each.
+	var (
+		n       = len(shape)
+		strides = make([]int, n)
+		index   = make([]int, n)
+		result  = make([]float64, 0, len(data))
+	)
+
+	stride := 1
+	for k := 0; k < n; k++ {
+		strides[k] = stride
+		stride *= shape[k]
+	}
+
+	for range data {
+		var offset int
+		for k := 0; k < n; k++ {
+			offset += index[k] * strides[k]
+		}
+
+		result = append(result, data[offset])
+		for k := n - 1; 0 <= k; k-- {
+			if index[k]++; index[k] < shape[k] {
+				break
+			}
+
+			index[k] = 0
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+// Package format holds the helpers shared by the fmt.Formatter implementations
+// of the matrix and vector packages.
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Elide returns the indices of the rows, columns or entries to show when
+// formatting k of them out of an array of a given size, in order. If the size
+// exceeds a threshold, only the first and last edge of them are shown and an
+// index of -1 marks where the rest are elided, as NumPy does.
+func Elide(k, size, threshold, edge int) []int {
+	shown := k
+	if threshold < size && 2*edge < k {
+		shown = edge
+	}
+
+	indices := make([]int, 0, 2*shown+1)
+	for i := 0; i < shown; i++ {
+		indices = append(indices, i)
+	}
+
+	if shown < k {
+		indices = append(indices, -1)
+		for i := k - shown; i < k; i++ {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+// Entry returns x formatted with a given strconv.FormatFloat format and the
+// precision and sign flag of a fmt.State.
+func Entry(f fmt.State, format byte, x float64) string {
+	prec, ok := f.Precision()
+	if !ok {
+		prec = -1
+	}
+
+	s := strconv.FormatFloat(x, format, prec, 64)
+	if f.Flag('+') && s[0] != '+' && s[0] != '-' {
+		s = "+" + s
+	}
+
+	return s
+}
+
+// Pad returns s padded with spaces to a given width, on the right if left is
+// true and on the left otherwise.
+func Pad(s string, width int, left bool) string {
+	if len(s) >= width {
+		return s
+	}
+
+	if left {
+		return s + strings.Repeat(" ", width-len(s))
+	}
+
+	return strings.Repeat(" ", width-len(s)) + s
+}
+
+// Verb returns the strconv.FormatFloat format for a verb and false if the verb
+// is not supported. The verbs v and s are the same as f, which is the format of
+// Vector.String.
+func Verb(verb rune) (byte, bool) {
+	switch verb {
+	case 'v', 's':
+		return 'f', true
+	case 'e', 'E', 'f', 'g', 'G':
+		return byte(verb), true
+	case 'F':
+		return 'f', true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,50 @@
+package format
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestElide(t *testing.T) {
+	tests := []struct {
+		k, size int
+		exp     []int
+	}{
+		{k: 4, size: 4, exp: []int{0, 1, 2, 3}},
+		{k: 4, size: 20, exp: []int{0, 1, 2, 3}},
+		{k: 7, size: 20, exp: []int{0, 1, 2, -1, 4, 5, 6}},
+		{k: 7, size: 7, exp: []int{0, 1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, test := range tests {
+		if rec := Elide(test.k, test.size, 10, 3); !reflect.DeepEqual(test.exp, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+}
+
+func TestPad(t *testing.T) {
+	if exp, rec := "  ab", Pad("ab", 4, false); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q", exp, rec)
+	}
+
+	if exp, rec := "ab  ", Pad("ab", 4, true); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q", exp, rec)
+	}
+
+	if exp, rec := "abc", Pad("abc", 2, true); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q", exp, rec)
+	}
+}
+
+func TestVerb(t *testing.T) {
+	for verb, exp := range map[rune]byte{'v': 'f', 's': 'f', 'e': 'e', 'F': 'f', 'G': 'G'} {
+		if rec, ok := Verb(verb); !ok || rec != exp {
+			t.Fatalf("\nexpected %c\nreceived %c", exp, rec)
+		}
+	}
+
+	if _, ok := Verb('d'); ok {
+		t.Fatalf("\nexpected %c to be unsupported", 'd')
+	}
+}
@@ -0,0 +1,193 @@
+package matrix
+
+import "github.com/nathangreene3/math/linalg/vector"
+
+// ------------------------------------------------------------------------------
+// BLOCK AND ELEMENT-WISE OPERATIONS ON MATRICES
+// ------------------------------------------------------------------------------
+// As with the other operations, A.F(B) updates A and F(A,B) returns a new
+// matrix.
+// ------------------------------------------------------------------------------
+
+// Apply returns the matrix with entries f(A[i][j]).
+func Apply(A Matrix, f func(float64) float64) Matrix {
+	B := A.Copy()
+	B.Apply(f)
+	return B
+}
+
+// Apply f to each entry of A.
+func (A Matrix) Apply(f func(float64) float64) {
+	for _, r := range A {
+		for j := range r {
+			r[j] = f(r[j])
+		}
+	}
+}
+
+// BlockDiagonal returns the block diagonal matrix with the given matrices on its
+// diagonal and zeroes elsewhere.
+func BlockDiagonal(As ...Matrix) Matrix {
+	var m, n int
+	for _, A := range As {
+		ma, na := A.Dimensions()
+		m += ma
+		n += na
+	}
+
+	B := Empty(m, n)
+	var i0, j0 int
+	for _, A := range As {
+		for i, r := range A {
+			copy(B[i0+i][j0:], r)
+		}
+
+		i0 += len(A)
+		j0 += len(A[0])
+	}
+
+	return B
+}
+
+// Hadamard returns the entry-wise product of two matrices.
+func Hadamard(A, B Matrix) Matrix {
+	C := A.Copy()
+	C.Hadamard(B)
+	return C
+}
+
+// Hadamard multiplies each entry of A by the corresponding entry of B.
+func (A Matrix) Hadamard(B Matrix) {
+	A.sameShape(B)
+	for i, r := range A {
+		for j := range r {
+			r[j] *= B[i][j]
+		}
+	}
+}
+
+// HadamardDivide returns the entry-wise quotient of two matrices.
+func HadamardDivide(A, B Matrix) Matrix {
+	C := A.Copy()
+	C.HadamardDivide(B)
+	return C
+}
+
+// HadamardDivide divides each entry of A by the corresponding entry of B.
+func (A Matrix) HadamardDivide(B Matrix) {
+	A.sameShape(B)
+	for i, r := range A {
+		for j := range r {
+			r[j] /= B[i][j]
+		}
+	}
+}
+
+// HStack returns the matrix formed by placing the given matrices side by side.
+// Each matrix must have the same number of rows.
+func HStack(As ...Matrix) Matrix {
+	if len(As) == 0 {
+		return Matrix{}
+	}
+
+	var (
+		m = len(As[0])
+		n int
+	)
+
+	for _, A := range As {
+		if m != len(A) {
+			panic("matrices must have equal number of rows")
+		}
+
+		if 0 < m {
+			_, na := A.Dimensions()
+			n += na
+		}
+	}
+
+	B := make(Matrix, 0, m)
+	for i := 0; i < m; i++ {
+		r := make(vector.Vector, 0, n)
+		for _, A := range As {
+			r = append(r, A[i]...)
+		}
+
+		B = append(B, r)
+	}
+
+	return B
+}
+
+// Kronecker returns the Kronecker product of an m-by-n matrix A and a p-by-q
+// matrix B, which is the mp-by-nq block matrix with block (i,j) equal to
+// A[i][j]*B.
+func Kronecker(A, B Matrix) Matrix {
+	var (
+		m, n = A.Dimensions()
+		p, q = B.Dimensions()
+	)
+
+	return New(m*p, n*q, func(i, j int) float64 { return A[i/p][j/q] * B[i%p][j%q] })
+}
+
+// sameShape panics if A and B do not have the same dimensions.
+func (A Matrix) sameShape(B Matrix) {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		panic("matrices must have the same dimensions")
+	}
+}
+
+// Submatrix returns the rows r0 to r1-1 and columns c0 to c1-1 of A. The
+// submatrix shares its entries with A, so updating one updates the other. Use
+// Copy to get an independent matrix.
+func (A Matrix) Submatrix(r0, r1, c0, c1 int) Matrix {
+	m, n := A.Dimensions()
+	if r0 < 0 || r1 < r0 || m < r1 || c0 < 0 || c1 < c0 || n < c1 {
+		panic("index out of range")
+	}
+
+	// Capping the capacity of each row keeps appends to the submatrix from
+	// overwriting the rest of A.
+	B := make(Matrix, 0, r1-r0)
+	for _, r := range A[r0:r1] {
+		B = append(B, r[c0:c1:c1])
+	}
+
+	return B
+}
+
+// VStack returns the matrix formed by placing the given matrices one above the
+// next. Each matrix must have the same number of columns.
+func VStack(As ...Matrix) Matrix {
+	var (
+		m int
+		n = -1
+	)
+
+	for _, A := range As {
+		if len(A) == 0 {
+			continue
+		}
+
+		ma, na := A.Dimensions()
+		if n < 0 {
+			n = na
+		} else if n != na {
+			panic("matrices must have equal number of columns")
+		}
+
+		m += ma
+	}
+
+	B := make(Matrix, 0, m)
+	for _, A := range As {
+		for _, r := range A {
+			B = append(B, r.Copy())
+		}
+	}
+
+	return B
+}
@@ -0,0 +1,131 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestApply(t *testing.T) {
+	var (
+		A   = Matrix{vector.Vector{1, 4}, vector.Vector{9, 16}}
+		exp = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+	)
+
+	if rec := Apply(A, gomath.Sqrt); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if A[1][1] != 16 {
+		t.Fatalf("\nexpected A unchanged\nreceived %v", A)
+	}
+}
+
+func TestBlockDiagonal(t *testing.T) {
+	var (
+		A   = Matrix{vector.Vector{1, 2}}
+		B   = Matrix{vector.Vector{3}, vector.Vector{4}}
+		exp = Matrix{
+			vector.Vector{1, 2, 0},
+			vector.Vector{0, 0, 3},
+			vector.Vector{0, 0, 4},
+		}
+	)
+
+	if rec := BlockDiagonal(A, B); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestHadamard(t *testing.T) {
+	var (
+		A = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		B = Matrix{vector.Vector{5, 6}, vector.Vector{7, 8}}
+		C = Matrix{vector.Vector{5, 12}, vector.Vector{21, 32}}
+	)
+
+	if rec := Hadamard(A, B); !rec.Equals(C) {
+		t.Fatalf("\nexpected %v\nreceived %v", C, rec)
+	}
+
+	if rec := HadamardDivide(C, B); !rec.Equals(A) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, rec)
+	}
+}
+
+func TestKronecker(t *testing.T) {
+	var (
+		A   = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		B   = Matrix{vector.Vector{0, 5}, vector.Vector{6, 7}}
+		exp = Matrix{
+			vector.Vector{0, 5, 0, 10},
+			vector.Vector{6, 7, 12, 14},
+			vector.Vector{0, 15, 0, 20},
+			vector.Vector{18, 21, 24, 28},
+		}
+	)
+
+	if rec := Kronecker(A, B); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// (A⊗B)(C⊗D) = AC⊗BD
+	var (
+		C = Matrix{vector.Vector{1, 0}, vector.Vector{2, 1}}
+		D = Matrix{vector.Vector{3}, vector.Vector{1}}
+	)
+
+	if rec, exp := Multiply(Kronecker(A, B), Kronecker(C, D)), Kronecker(Multiply(A, C), Multiply(B, D)); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestStack(t *testing.T) {
+	var (
+		A = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		B = Matrix{vector.Vector{5}, vector.Vector{6}}
+		C = Matrix{vector.Vector{7, 8}}
+	)
+
+	expH := Matrix{vector.Vector{1, 2, 5, 1, 2}, vector.Vector{3, 4, 6, 3, 4}}
+	if rec := HStack(A, B, A); !rec.Equals(expH) {
+		t.Fatalf("\nexpected %v\nreceived %v", expH, rec)
+	}
+
+	expV := Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}, vector.Vector{7, 8}}
+	rec := VStack(A, Matrix{}, C)
+	if !rec.Equals(expV) {
+		t.Fatalf("\nexpected %v\nreceived %v", expV, rec)
+	}
+
+	// The stacked matrix is independent of its parts.
+	rec[0][0] = 0
+	if A[0][0] != 1 {
+		t.Fatalf("\nexpected A unchanged\nreceived %v", A)
+	}
+}
+
+func TestSubmatrix(t *testing.T) {
+	var (
+		A   = New(4, 5, func(i, j int) float64 { return float64(10*i + j) })
+		exp = Matrix{vector.Vector{11, 12, 13}, vector.Vector{21, 22, 23}}
+		S   = A.Submatrix(1, 3, 1, 4)
+	)
+
+	if !S.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, S)
+	}
+
+	// The submatrix is a view into A.
+	S[0][0] = -1
+	if A[1][1] != -1 {
+		t.Fatalf("\nexpected %v\nreceived %v", -1, A[1][1])
+	}
+
+	// Appending to a row of the submatrix does not overwrite A.
+	_ = append(S[0], -2)
+	if A[1][4] != 14 {
+		t.Fatalf("\nexpected %v\nreceived %v", 14, A[1][4])
+	}
+}
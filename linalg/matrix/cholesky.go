@@ -0,0 +1,131 @@
+package matrix
+
+import (
+	"errors"
+	gomath "math"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ErrNotPositiveDefinite is returned when a matrix is not symmetric positive
+// definite.
+var ErrNotPositiveDefinite = errors.New("matrix is not symmetric positive definite")
+
+// Cholesky is the decomposition A = LL^T of a symmetric positive definite
+// matrix A, where L is lower triangular with a positive diagonal.
+type Cholesky struct {
+	l Matrix
+}
+
+// NewCholesky returns the Cholesky decomposition of a square matrix A. If A is
+// not symmetric positive definite, ErrNotPositiveDefinite is returned.
+func NewCholesky(A Interface) (*Cholesky, error) {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	L := Empty(n, n)
+	for j := 0; j < n; j++ {
+		d := A.At(j, j)
+		for k := 0; k < j; k++ {
+			d -= L[j][k] * L[j][k]
+		}
+
+		if d <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+
+		L[j][j] = gomath.Sqrt(d)
+		for i := j + 1; i < n; i++ {
+			s := A.At(i, j)
+			if s != A.At(j, i) {
+				return nil, ErrNotPositiveDefinite
+			}
+
+			for k := 0; k < j; k++ {
+				s -= L[i][k] * L[j][k]
+			}
+
+			L[i][j] = s / L[j][j]
+		}
+	}
+
+	return &Cholesky{l: L}, nil
+}
+
+// Determinant returns the determinant of the factored matrix.
+func (F *Cholesky) Determinant() float64 {
+	return gomath.Exp(F.LogDeterminant())
+}
+
+// Inverse returns the inverse of the factored matrix.
+func (F *Cholesky) Inverse() Matrix {
+	n := len(F.l)
+	return F.SolveMatrix(Identity(n, n))
+}
+
+// L returns the lower triangular factor.
+func (F *Cholesky) L() Matrix {
+	return F.l.Copy()
+}
+
+// LogDeterminant returns the natural logarithm of the determinant of the
+// factored matrix. Unlike Determinant, this does not overflow for large
+// matrices.
+func (F *Cholesky) LogDeterminant() float64 {
+	var s float64
+	for i, r := range F.l {
+		s += gomath.Log(r[i])
+	}
+
+	return 2 * s
+}
+
+// Solve Ax=y for x.
+func (F *Cholesky) Solve(y vector.Vector) vector.Vector {
+	n := len(F.l)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	// Forward substitution solves Lz = y, then back substitution solves
+	// L^T x = z.
+	x := y.Copy()
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= F.l[i][j] * x[j]
+		}
+
+		x[i] /= F.l[i][i]
+	}
+
+	for i := n - 1; 0 <= i; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= F.l[j][i] * x[j]
+		}
+
+		x[i] /= F.l[i][i]
+	}
+
+	return x
+}
+
+// SolveMatrix solves AX=B for X, treating each column of B as a separate
+// right-hand side.
+func (F *Cholesky) SolveMatrix(B Matrix) Matrix {
+	m, n := B.Dimensions()
+	if m != len(F.l) {
+		panic("dimension mismatch")
+	}
+
+	X := Empty(m, n)
+	for j := 0; j < n; j++ {
+		x := F.Solve(vector.New(m, func(i int) float64 { return B[i][j] }))
+		for i := 0; i < m; i++ {
+			X[i][j] = x[i]
+		}
+	}
+
+	return X
+}
@@ -0,0 +1,59 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestCholesky(t *testing.T) {
+	var (
+		A = Matrix{
+			vector.Vector{4, 12, -16},
+			vector.Vector{12, 37, -43},
+			vector.Vector{-16, -43, 98},
+		}
+		expL = Matrix{
+			vector.Vector{2, 0, 0},
+			vector.Vector{6, 1, 0},
+			vector.Vector{-8, 5, 3},
+		}
+	)
+
+	F, err := NewCholesky(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if L := F.L(); !L.Approx(expL, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", expL, L)
+	}
+
+	// det(A) = (2*1*3)^2 = 36
+	if logDet := F.LogDeterminant(); 1e-12 < gomath.Abs(logDet-gomath.Log(36)) {
+		t.Fatalf("\nexpected %v\nreceived %v", gomath.Log(36), logDet)
+	}
+
+	x := vector.Vector{1, 2, 3}
+	if rec := F.Solve(Multiply(A, ColumnMatrix(x)).Vector()); !rec.Approx(x, 1e-10) {
+		t.Fatalf("\nexpected %v\nreceived %v", x, rec)
+	}
+
+	if rec := Multiply(A, F.Inverse()); !rec.Approx(Identity(3, 3), 1e-10) {
+		t.Fatalf("\nexpected %v\nreceived %v", Identity(3, 3), rec)
+	}
+
+	tests := []Matrix{
+		{vector.Vector{1, 2}, vector.Vector{2, 1}},  // Indefinite
+		{vector.Vector{1, 0}, vector.Vector{1, 1}},  // Not symmetric
+		{vector.Vector{0, 0}, vector.Vector{0, 1}},  // Semi-definite
+		{vector.Vector{-1, 0}, vector.Vector{0, 1}}, // Negative diagonal
+	}
+
+	for _, B := range tests {
+		if _, err := NewCholesky(B); err != ErrNotPositiveDefinite {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrNotPositiveDefinite, err)
+		}
+	}
+}
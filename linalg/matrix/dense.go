@@ -0,0 +1,231 @@
+package matrix
+
+import "github.com/nathangreene3/math/linalg/vector"
+
+// Dense is a matrix stored in a single row-major slice. The (i,j)th entry is
+// data[i*stride+j], or data[j*stride+i] if the matrix is transposed. Row,
+// column, submatrix and transpose views share data with the matrix they are
+// taken from, so changes to one are seen by the other.
+type Dense struct {
+	m, n   int
+	stride int
+	data   []float64
+	trans  bool
+}
+
+// ------------------------------------------------------------------------------
+// DENSE CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// NewDense returns an m-by-n matrix backed by data, which is read in row-major
+// order. If data is nil, a zero matrix is allocated.
+func NewDense(m, n int, data []float64) *Dense {
+	switch {
+	case m < 0 || n < 0:
+		panic("dimensions must be non-negative")
+	case data == nil:
+		data = make([]float64, m*n)
+	case len(data) != m*n:
+		panic("data length must equal the number of entries")
+	}
+
+	return &Dense{m: m, n: n, stride: n, data: data}
+}
+
+// Dense returns A copied into a single contiguous slice.
+func (A Matrix) Dense() *Dense {
+	m, n := A.Dimensions()
+	D := NewDense(m, n, nil)
+	for i, r := range A {
+		copy(D.data[i*n:(i+1)*n], r)
+	}
+
+	return D
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON DENSE MATRICES
+// ------------------------------------------------------------------------------
+
+// Add B to D.
+func (D *Dense) Add(B *Dense) {
+	if D.m != B.m || D.n != B.n {
+		panic("matrices must have the same number of rows and columns")
+	}
+
+	for i := 0; i < D.m; i++ {
+		for j := 0; j < D.n; j++ {
+			*D.at(i, j) += B.At(i, j)
+		}
+	}
+}
+
+// at returns a pointer to the (i,j)th entry.
+func (D *Dense) at(i, j int) *float64 {
+	if D.trans {
+		return &D.data[j*D.stride+i]
+	}
+
+	return &D.data[i*D.stride+j]
+}
+
+// At returns the (i,j)th entry.
+func (D *Dense) At(i, j int) float64 {
+	if i < 0 || D.m <= i || j < 0 || D.n <= j {
+		panic("index out of range")
+	}
+
+	return *D.at(i, j)
+}
+
+// Column returns a view of the jth column as an m-by-1 matrix.
+func (D *Dense) Column(j int) *Dense {
+	return D.Submatrix(0, D.m, j, j+1)
+}
+
+// Copy returns a deep copy of D in contiguous, non-transposed storage.
+func (D *Dense) Copy() *Dense {
+	C := NewDense(D.m, D.n, nil)
+	for i := 0; i < D.m; i++ {
+		if D.trans {
+			for j := 0; j < D.n; j++ {
+				C.data[i*D.n+j] = *D.at(i, j)
+			}
+		} else {
+			copy(C.data[i*D.n:(i+1)*D.n], D.data[i*D.stride:i*D.stride+D.n])
+		}
+	}
+
+	return C
+}
+
+// Dimensions returns the number of rows and columns.
+func (D *Dense) Dimensions() (int, int) {
+	return D.m, D.n
+}
+
+// Matrix returns D copied into a Matrix.
+func (D *Dense) Matrix() Matrix {
+	return New(D.m, D.n, func(i, j int) float64 { return *D.at(i, j) })
+}
+
+// MultiplyDense returns AB.
+func MultiplyDense(A, B *Dense) *Dense {
+	if A.n != B.m {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	// Copying puts both operands in row-major order, so the innermost loop
+	// runs along contiguous rows of B and C.
+	A, B = A.contiguous(), B.contiguous()
+	C := NewDense(A.m, B.n, nil)
+	for i := 0; i < A.m; i++ {
+		c := C.data[i*C.stride : i*C.stride+C.n]
+		for k := 0; k < A.n; k++ {
+			a, b := A.data[i*A.stride+k], B.data[k*B.stride:k*B.stride+B.n]
+			for j := range c {
+				c[j] += a * b[j]
+			}
+		}
+	}
+
+	return C
+}
+
+// contiguous returns D if it is not transposed, or a non-transposed copy
+// otherwise.
+func (D *Dense) contiguous() *Dense {
+	if D.trans {
+		return D.Copy()
+	}
+
+	return D
+}
+
+// RawData returns the backing slice and row stride of a non-transposed matrix,
+// so it can be handed to code expecting row-major storage. The (i,j)th entry
+// is data[i*stride+j].
+func (D *Dense) RawData() ([]float64, int) {
+	if D.trans {
+		panic("transposed matrix is not stored in row-major order")
+	}
+
+	return D.data, D.stride
+}
+
+// Row returns a view of the ith row as a 1-by-n matrix.
+func (D *Dense) Row(i int) *Dense {
+	return D.Submatrix(i, i+1, 0, D.n)
+}
+
+// RowVector returns the ith row of a non-transposed matrix as a vector sharing
+// storage with D.
+func (D *Dense) RowVector(i int) vector.Vector {
+	if D.trans {
+		panic("transposed matrix is not stored in row-major order")
+	}
+
+	if i < 0 || D.m <= i {
+		panic("index out of range")
+	}
+
+	return vector.Vector(D.data[i*D.stride : i*D.stride+D.n : i*D.stride+D.n])
+}
+
+// ScalarMultiply D by a.
+func (D *Dense) ScalarMultiply(a float64) {
+	for i := 0; i < D.m; i++ {
+		for j := 0; j < D.n; j++ {
+			*D.at(i, j) *= a
+		}
+	}
+}
+
+// Set the (i,j)th entry to a.
+func (D *Dense) Set(i, j int, a float64) {
+	if i < 0 || D.m <= i || j < 0 || D.n <= j {
+		panic("index out of range")
+	}
+
+	*D.at(i, j) = a
+}
+
+// Submatrix returns a view of rows [i0,i1) and columns [j0,j1).
+func (D *Dense) Submatrix(i0, i1, j0, j1 int) *Dense {
+	if i0 < 0 || i1 < i0 || D.m < i1 || j0 < 0 || j1 < j0 || D.n < j1 {
+		panic("index out of range")
+	}
+
+	S := &Dense{m: i1 - i0, n: j1 - j0, stride: D.stride, trans: D.trans}
+	if S.m == 0 || S.n == 0 {
+		return S
+	}
+
+	// The view starts at the (i0,j0)th entry and ends just after the last
+	// entry it covers.
+	start, end := i0*D.stride+j0, (i1-1)*D.stride+j1
+	if D.trans {
+		start, end = j0*D.stride+i0, (j1-1)*D.stride+i1
+	}
+
+	S.data = D.data[start:end:end]
+	return S
+}
+
+// Subtract B from D.
+func (D *Dense) Subtract(B *Dense) {
+	if D.m != B.m || D.n != B.n {
+		panic("matrices must have the same number of rows and columns")
+	}
+
+	for i := 0; i < D.m; i++ {
+		for j := 0; j < D.n; j++ {
+			*D.at(i, j) -= B.At(i, j)
+		}
+	}
+}
+
+// T returns a view of the transpose of D. No data is copied.
+func (D *Dense) T() *Dense {
+	return &Dense{m: D.n, n: D.m, stride: D.stride, data: D.data, trans: !D.trans}
+}
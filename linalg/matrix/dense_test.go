@@ -0,0 +1,60 @@
+package matrix
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestDense(t *testing.T) {
+	var (
+		A = New(3, 4, func(i, j int) float64 { return float64(4*i + j) })
+		D = A.Dense()
+	)
+
+	if rec := D.Matrix(); !rec.Equals(A) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, rec)
+	}
+
+	if rec := D.T().Matrix(); !rec.Equals(A.Transpose()) {
+		t.Fatalf("\nexpected %v\nreceived %v", A.Transpose(), rec)
+	}
+
+	// Views share storage with D.
+	S := D.Submatrix(1, 3, 1, 3)
+	S.Set(0, 0, -1)
+	if a := D.At(1, 1); a != -1 {
+		t.Fatalf("\nexpected -1\nreceived %v", a)
+	}
+
+	if rec, exp := S.Matrix(), (Matrix{vector.Vector{-1, 6}, vector.Vector{9, 10}}); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec, exp := D.T().Submatrix(2, 4, 0, 2).Matrix(), (Matrix{vector.Vector{2, 6}, vector.Vector{3, 7}}); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec, exp := D.Column(2).Matrix(), ColumnMatrix(vector.Vector{2, 6, 10}); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec, exp := D.T().Row(2).Matrix(), RowMatrix(vector.Vector{2, 6, 10}); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	D.RowVector(2).Multiply(2)
+	if rec, exp := D.Row(2).Matrix(), RowMatrix(vector.Vector{16, 18, 20, 22}); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestMultiplyDense(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	A, B := random(r, 7, 5), random(r, 7, 9)
+	exp := Multiply(A.Transpose(), B)
+	if rec := MultiplyDense(A.Dense().T(), B.Dense()).Matrix(); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
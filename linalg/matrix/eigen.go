@@ -0,0 +1,669 @@
+package matrix
+
+import (
+	"errors"
+	gomath "math"
+	"sort"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The general eigensolver follows the EISPACK routines orthes and hqr2 as
+// presented in JAMA, the Java Matrix Package, by The MathWorks and NIST.
+// ------------------------------------------------------------------------------
+
+var (
+	// ErrNotSymmetric is returned when a matrix is not equal to its transpose.
+	ErrNotSymmetric = errors.New("matrix is not symmetric")
+
+	// ErrNoConvergence is returned when an iterative method fails to converge.
+	ErrNoConvergence = errors.New("iteration failed to converge")
+)
+
+// maxEigenIters is the number of iterations (sweeps for a symmetric matrix) an
+// eigensolver may take before giving up.
+const maxEigenIters = 100
+
+// EigenSym is the decomposition A = VDV^T of a symmetric matrix A, where D is
+// diagonal and V is orthogonal.
+type EigenSym struct {
+	values  vector.Vector
+	vectors Matrix
+}
+
+// NewEigenSym returns the eigen-decomposition of a symmetric matrix A computed
+// by the cyclic Jacobi method. Eigenvalues are sorted in ascending order.
+func NewEigenSym(A Interface) (*EigenSym, error) {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if A.At(i, j) != A.At(j, i) {
+				return nil, ErrNotSymmetric
+			}
+		}
+	}
+
+	var (
+		D    = Materialize(A)
+		V    = Identity(n, n)
+		norm = D.FrobeniusNorm() // Invariant under rotation
+	)
+
+	for sweep := 0; ; sweep++ {
+		var off float64
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off = gomath.Hypot(off, D[p][q])
+			}
+		}
+
+		if off <= epsilon*norm {
+			break
+		}
+
+		if sweep == maxEigenIters {
+			return nil, ErrNoConvergence
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if D[p][q] == 0 {
+					continue
+				}
+
+				// Choose the smaller rotation angle that zeroes D[p][q].
+				theta := (D[q][q] - D[p][p]) / (2 * D[p][q])
+				t := 1 / (gomath.Abs(theta) + gomath.Hypot(theta, 1))
+				if theta < 0 {
+					t = -t
+				}
+
+				c := 1 / gomath.Hypot(t, 1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					dp, dq := D[k][p], D[k][q]
+					D[k][p], D[k][q] = c*dp-s*dq, s*dp+c*dq
+				}
+
+				for k := 0; k < n; k++ {
+					dp, dq := D[p][k], D[q][k]
+					D[p][k], D[q][k] = c*dp-s*dq, s*dp+c*dq
+				}
+
+				for k := 0; k < n; k++ {
+					vp, vq := V[k][p], V[k][q]
+					V[k][p], V[k][q] = c*vp-s*vq, s*vp+c*vq
+				}
+
+				D[p][q], D[q][p] = 0, 0
+			}
+		}
+	}
+
+	order := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		order = append(order, i)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return D[order[i]][order[i]] < D[order[j]][order[j]] })
+	return &EigenSym{
+		values:  vector.New(n, func(i int) float64 { return D[order[i]][order[i]] }),
+		vectors: New(n, n, func(i, j int) float64 { return V[i][order[j]] }),
+	}, nil
+}
+
+// Values returns the eigenvalues in ascending order.
+func (E *EigenSym) Values() vector.Vector {
+	return E.values.Copy()
+}
+
+// Vectors returns an orthogonal matrix whose jth column is the unit eigenvector
+// corresponding to the jth eigenvalue.
+func (E *EigenSym) Vectors() Matrix {
+	return E.vectors.Copy()
+}
+
+// Eigen is the eigen-decomposition of a general real square matrix, whose
+// eigenvalues and eigenvectors may be complex.
+type Eigen struct {
+	d, e vector.Vector // Real and imaginary parts of the eigenvalues
+	v    Matrix        // Eigenvectors; a complex pair u+iv, u-iv is stored as columns u, v
+}
+
+// NewEigen returns the eigen-decomposition of a square matrix A. A is first
+// reduced to upper Hessenberg form by orthogonal similarity transformations,
+// then to real Schur form by the shifted QR algorithm.
+func NewEigen(A Interface) (*Eigen, error) {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	E := &Eigen{
+		d: vector.Zero(n),
+		e: vector.Zero(n),
+		v: Identity(n, n),
+	}
+
+	H := Materialize(A)
+	E.orthes(H)
+	if err := E.hqr2(H); err != nil {
+		return nil, err
+	}
+
+	return E, nil
+}
+
+// orthes reduces H to upper Hessenberg form by Householder similarity
+// transformations, accumulating them in E.v.
+func (E *Eigen) orthes(H Matrix) {
+	var (
+		n   = len(H)
+		ort = vector.Zero(n)
+		V   = E.v
+	)
+
+	for m := 1; m < n-1; m++ {
+		var scale float64
+		for i := m; i < n; i++ {
+			scale += gomath.Abs(H[i][m-1])
+		}
+
+		if scale == 0 {
+			continue
+		}
+
+		var h float64
+		for i := n - 1; m <= i; i-- {
+			ort[i] = H[i][m-1] / scale
+			h += ort[i] * ort[i]
+		}
+
+		g := gomath.Sqrt(h)
+		if 0 < ort[m] {
+			g = -g
+		}
+
+		h -= ort[m] * g
+		ort[m] -= g
+
+		// Apply the transformation H = (I-uu'/h)H(I-uu'/h).
+		for j := m; j < n; j++ {
+			var f float64
+			for i := n - 1; m <= i; i-- {
+				f += ort[i] * H[i][j]
+			}
+
+			f /= h
+			for i := m; i < n; i++ {
+				H[i][j] -= f * ort[i]
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			var f float64
+			for j := n - 1; m <= j; j-- {
+				f += ort[j] * H[i][j]
+			}
+
+			f /= h
+			for j := m; j < n; j++ {
+				H[i][j] -= f * ort[j]
+			}
+		}
+
+		ort[m] *= scale
+		H[m][m-1] = scale * g
+	}
+
+	for m := n - 2; 1 <= m; m-- {
+		if H[m][m-1] == 0 {
+			continue
+		}
+
+		for i := m + 1; i < n; i++ {
+			ort[i] = H[i][m-1]
+		}
+
+		for j := m; j < n; j++ {
+			var g float64
+			for i := m; i < n; i++ {
+				g += ort[i] * V[i][j]
+			}
+
+			// Double division avoids possible underflow.
+			g = (g / ort[m]) / H[m][m-1]
+			for i := m; i < n; i++ {
+				V[i][j] += g * ort[i]
+			}
+		}
+	}
+}
+
+// hqr2 reduces the upper Hessenberg matrix H to real Schur form, storing the
+// eigenvalues in E.d and E.e and the eigenvectors in E.v.
+func (E *Eigen) hqr2(H Matrix) error {
+	var (
+		nn                  = len(H)
+		n                   = nn - 1
+		d, e, V             = E.d, E.e, E.v
+		exshift, norm       float64
+		p, q, r, s, t, w, x float64
+		y, z                float64
+		iter                int
+	)
+
+	for i := 0; i < nn; i++ {
+		for j := math.MaxInt(i-1, 0); j < nn; j++ {
+			norm += gomath.Abs(H[i][j])
+		}
+	}
+
+	for 0 <= n {
+		// Look for a single small sub-diagonal entry.
+		l := n
+		for ; 0 < l; l-- {
+			s = gomath.Abs(H[l-1][l-1]) + gomath.Abs(H[l][l])
+			if s == 0 {
+				s = norm
+			}
+
+			if gomath.Abs(H[l][l-1]) < epsilon*s {
+				break
+			}
+		}
+
+		switch l {
+		case n:
+			// One root found
+			H[n][n] += exshift
+			d[n], e[n] = H[n][n], 0
+			n--
+			iter = 0
+		case n - 1:
+			// Two roots found
+			w = H[n][n-1] * H[n-1][n]
+			p = (H[n-1][n-1] - H[n][n]) / 2
+			q = p*p + w
+			z = gomath.Sqrt(gomath.Abs(q))
+			H[n][n] += exshift
+			H[n-1][n-1] += exshift
+			x = H[n][n]
+
+			if q < 0 {
+				// Complex pair
+				d[n-1], d[n] = x+p, x+p
+				e[n-1], e[n] = z, -z
+				n -= 2
+				iter = 0
+				continue
+			}
+
+			// Real pair
+			if 0 <= p {
+				z = p + z
+			} else {
+				z = p - z
+			}
+
+			d[n-1], d[n] = x+z, x+z
+			if z != 0 {
+				d[n] = x - w/z
+			}
+
+			e[n-1], e[n] = 0, 0
+			x = H[n][n-1]
+			s = gomath.Abs(x) + gomath.Abs(z)
+			p, q = x/s, z/s
+			r = gomath.Hypot(p, q)
+			p, q = p/r, q/r
+
+			for j := n - 1; j < nn; j++ {
+				z = H[n-1][j]
+				H[n-1][j] = q*z + p*H[n][j]
+				H[n][j] = q*H[n][j] - p*z
+			}
+
+			for i := 0; i <= n; i++ {
+				z = H[i][n-1]
+				H[i][n-1] = q*z + p*H[i][n]
+				H[i][n] = q*H[i][n] - p*z
+			}
+
+			for i := 0; i < nn; i++ {
+				z = V[i][n-1]
+				V[i][n-1] = q*z + p*V[i][n]
+				V[i][n] = q*V[i][n] - p*z
+			}
+
+			n -= 2
+			iter = 0
+		default:
+			// No convergence yet
+			if iter == maxEigenIters {
+				return ErrNoConvergence
+			}
+
+			// Form the shift.
+			x, y, w = H[n][n], H[n-1][n-1], H[n][n-1]*H[n-1][n]
+
+			// Wilkinson's original ad hoc shift
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					H[i][i] -= x
+				}
+
+				s = gomath.Abs(H[n][n-1]) + gomath.Abs(H[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if 0 < s {
+					s = gomath.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						H[i][i] -= s
+					}
+
+					exshift += s
+					x, y, w = 0.964, 0.964, 0.964
+				}
+			}
+
+			iter++
+
+			// Look for two consecutive small sub-diagonal entries.
+			m := n - 2
+			for ; l <= m; m-- {
+				z = H[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/H[m+1][m] + H[m][m+1]
+				q = H[m+1][m+1] - z - r - s
+				r = H[m+2][m+1]
+				s = gomath.Abs(p) + gomath.Abs(q) + gomath.Abs(r)
+				p, q, r = p/s, q/s, r/s
+				if m == l {
+					break
+				}
+
+				if gomath.Abs(H[m][m-1])*(gomath.Abs(q)+gomath.Abs(r)) < epsilon*(gomath.Abs(p)*(gomath.Abs(H[m-1][m-1])+gomath.Abs(z)+gomath.Abs(H[m+1][m+1]))) {
+					break
+				}
+			}
+
+			for i := m + 2; i <= n; i++ {
+				H[i][i-2] = 0
+				if m+2 < i {
+					H[i][i-3] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n
+			for k := m; k < n; k++ {
+				notLast := k != n-1
+				if k != m {
+					p, q, r = H[k][k-1], H[k+1][k-1], 0
+					if notLast {
+						r = H[k+2][k-1]
+					}
+
+					x = gomath.Abs(p) + gomath.Abs(q) + gomath.Abs(r)
+					if x == 0 {
+						continue
+					}
+
+					p, q, r = p/x, q/x, r/x
+				}
+
+				s = gomath.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+
+				if s == 0 {
+					continue
+				}
+
+				if k != m {
+					H[k][k-1] = -s * x
+				} else if l != m {
+					H[k][k-1] = -H[k][k-1]
+				}
+
+				p += s
+				x, y, z = p/s, q/s, r/s
+				q, r = q/p, r/p
+
+				for j := k; j < nn; j++ {
+					p = H[k][j] + q*H[k+1][j]
+					if notLast {
+						p += r * H[k+2][j]
+						H[k+2][j] -= p * z
+					}
+
+					H[k][j] -= p * x
+					H[k+1][j] -= p * y
+				}
+
+				for i := 0; i <= math.MinInt(n, k+3); i++ {
+					p = x*H[i][k] + y*H[i][k+1]
+					if notLast {
+						p += z * H[i][k+2]
+						H[i][k+2] -= p * r
+					}
+
+					H[i][k] -= p
+					H[i][k+1] -= p * q
+				}
+
+				for i := 0; i < nn; i++ {
+					p = x*V[i][k] + y*V[i][k+1]
+					if notLast {
+						p += z * V[i][k+2]
+						V[i][k+2] -= p * r
+					}
+
+					V[i][k] -= p
+					V[i][k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	if norm == 0 {
+		return nil
+	}
+
+	// Back substitute to find the eigenvectors of the upper triangular form.
+	for n = nn - 1; 0 <= n; n-- {
+		p, q = d[n], e[n]
+		switch {
+		case q == 0:
+			// Real vector
+			l := n
+			H[n][n] = 1
+			for i := n - 1; 0 <= i; i-- {
+				w = H[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += H[i][j] * H[j][n]
+				}
+
+				if e[i] < 0 {
+					z, s = w, r
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						H[i][n] = -r / w
+					} else {
+						H[i][n] = -r / (epsilon * norm)
+					}
+				} else {
+					// Solve real equations.
+					x, y = H[i][i+1], H[i+1][i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					H[i][n] = t
+					if gomath.Abs(z) < gomath.Abs(x) {
+						H[i+1][n] = (-r - w*t) / x
+					} else {
+						H[i+1][n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control
+				if t = gomath.Abs(H[i][n]); 1 < (epsilon*t)*t {
+					for j := i; j <= n; j++ {
+						H[j][n] /= t
+					}
+				}
+			}
+		case q < 0:
+			// Complex vector
+			l := n - 1
+
+			// The last vector component is imaginary, so the matrix is
+			// triangular.
+			if gomath.Abs(H[n-1][n]) < gomath.Abs(H[n][n-1]) {
+				H[n-1][n-1] = q / H[n][n-1]
+				H[n-1][n] = -(H[n][n] - p) / H[n][n-1]
+			} else {
+				c := complex(0, -H[n-1][n]) / complex(H[n-1][n-1]-p, q)
+				H[n-1][n-1], H[n-1][n] = real(c), imag(c)
+			}
+
+			H[n][n-1], H[n][n] = 0, 1
+			for i := n - 2; 0 <= i; i-- {
+				var ra, sa float64
+				for j := l; j <= n; j++ {
+					ra += H[i][j] * H[j][n-1]
+					sa += H[i][j] * H[j][n]
+				}
+
+				w = H[i][i] - p
+				if e[i] < 0 {
+					z, r, s = w, ra, sa
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					H[i][n-1], H[i][n] = real(c), imag(c)
+				} else {
+					// Solve complex equations.
+					x, y = H[i][i+1], H[i+1][i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = epsilon * norm * (gomath.Abs(w) + gomath.Abs(q) + gomath.Abs(x) + gomath.Abs(y) + gomath.Abs(z))
+					}
+
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					H[i][n-1], H[i][n] = real(c), imag(c)
+					if gomath.Abs(z)+gomath.Abs(q) < gomath.Abs(x) {
+						H[i+1][n-1] = (-ra - w*H[i][n-1] + q*H[i][n]) / x
+						H[i+1][n] = (-sa - w*H[i][n] - q*H[i][n-1]) / x
+					} else {
+						c = complex(-r-y*H[i][n-1], -s-y*H[i][n]) / complex(z, q)
+						H[i+1][n-1], H[i+1][n] = real(c), imag(c)
+					}
+				}
+
+				// Overflow control
+				if t = gomath.Max(gomath.Abs(H[i][n-1]), gomath.Abs(H[i][n])); 1 < (epsilon*t)*t {
+					for j := i; j <= n; j++ {
+						H[j][n-1] /= t
+						H[j][n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transform to get the eigenvectors of the original matrix.
+	for j := nn - 1; 0 <= j; j-- {
+		for i := 0; i < nn; i++ {
+			z = 0
+			for k := 0; k <= j; k++ {
+				z += V[i][k] * H[k][j]
+			}
+
+			V[i][j] = z
+		}
+	}
+
+	return nil
+}
+
+// IsReal returns true if every eigenvalue is real.
+func (E *Eigen) IsReal() bool {
+	for _, b := range E.e {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Values returns the eigenvalues. Complex eigenvalues appear in conjugate
+// pairs, with the positive imaginary part first.
+func (E *Eigen) Values() []complex128 {
+	values := make([]complex128, 0, len(E.d))
+	for i, a := range E.d {
+		values = append(values, complex(a, E.e[i]))
+	}
+
+	return values
+}
+
+// Vectors returns the eigenvectors, where the jth vector corresponds to the jth
+// eigenvalue.
+func (E *Eigen) Vectors() [][]complex128 {
+	n := len(E.d)
+	vectors := make([][]complex128, 0, n)
+	for j := 0; j < n; j++ {
+		v := make([]complex128, 0, n)
+		switch {
+		case E.e[j] == 0:
+			for i := 0; i < n; i++ {
+				v = append(v, complex(E.v[i][j], 0))
+			}
+		case 0 < E.e[j]:
+			for i := 0; i < n; i++ {
+				v = append(v, complex(E.v[i][j], E.v[i][j+1]))
+			}
+		default:
+			for i := 0; i < n; i++ {
+				v = append(v, complex(E.v[i][j-1], -E.v[i][j]))
+			}
+		}
+
+		vectors = append(vectors, v)
+	}
+
+	return vectors
+}
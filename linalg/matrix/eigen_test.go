@@ -0,0 +1,110 @@
+package matrix
+
+import (
+	gomath "math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestEigenSym(t *testing.T) {
+	var (
+		A = Matrix{
+			vector.Vector{2, -1, 0},
+			vector.Vector{-1, 2, -1},
+			vector.Vector{0, -1, 2},
+		}
+		exp = vector.Vector{2 - gomath.Sqrt2, 2, 2 + gomath.Sqrt2}
+	)
+
+	E, err := NewEigenSym(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rec := E.Values(); !rec.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// A = VDV^T
+	V := E.Vectors()
+	D := New(3, 3, func(i, j int) float64 {
+		if i == j {
+			return exp[i]
+		}
+		return 0
+	})
+
+	if rec := Multiply(V, D, V.Transpose()); !rec.Approx(A, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, rec)
+	}
+
+	if _, err := NewEigenSym(Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}); err != ErrNotSymmetric {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrNotSymmetric, err)
+	}
+}
+
+func TestEigen(t *testing.T) {
+	phi := (1 + gomath.Sqrt(5)) / 2
+	tests := []struct {
+		A   Matrix
+		exp []complex128
+	}{
+		{
+			// Fibonacci recurrence
+			A:   Matrix{vector.Vector{0, 1}, vector.Vector{1, 1}},
+			exp: []complex128{complex(1-phi, 0), complex(phi, 0)},
+		},
+		{
+			// Rotation by a quarter turn
+			A:   Matrix{vector.Vector{0, -1}, vector.Vector{1, 0}},
+			exp: []complex128{-1i, 1i},
+		},
+		{
+			A: Matrix{
+				vector.Vector{1, 2, 0, 0},
+				vector.Vector{-2, 1, 0, 0},
+				vector.Vector{3, 1, 4, 1},
+				vector.Vector{0, 5, 0, 2},
+			},
+			exp: []complex128{1 - 2i, 1 + 2i, 2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		E, err := NewEigen(test.A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := E.Values()
+		for j, v := range E.Vectors() {
+			// Av = lambda v
+			for i := range test.A {
+				var s complex128
+				for k, a := range test.A[i] {
+					s += complex(a, 0) * v[k]
+				}
+
+				if 1e-10 < cmplx.Abs(s-values[j]*v[i]) {
+					t.Fatalf("\n%v is not an eigenvector of %v for eigenvalue %v", v, test.A, values[j])
+				}
+			}
+		}
+
+		sort.Slice(values, func(i, j int) bool {
+			if real(values[i]) != real(values[j]) {
+				return real(values[i]) < real(values[j])
+			}
+			return imag(values[i]) < imag(values[j])
+		})
+
+		for i := range values {
+			if 1e-10 < cmplx.Abs(values[i]-test.exp[i]) {
+				t.Fatalf("\nexpected %v\nreceived %v", test.exp, values)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package matrix
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/nathangreene3/math/linalg/internal/codec"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+var (
+	// ErrFormat is returned when decoding input that is not in the expected
+	// format. It is the same error as vector.ErrFormat.
+	ErrFormat = vector.ErrFormat
+
+	// ErrUnsupported is returned when decoding well-formed input that uses an
+	// unsupported feature, such as complex entries. It is the same error as
+	// vector.ErrUnsupported.
+	ErrUnsupported = vector.ErrUnsupported
+)
+
+// ------------------------------------------------------------------------------
+// ENCODING AND DECODING
+// ------------------------------------------------------------------------------
+// Every entry is written with the fewest digits that parse back to the same
+// value, so decoding what was encoded reproduces the matrix exactly. A matrix
+// with no rows decodes as an empty, non-nil matrix.
+// ------------------------------------------------------------------------------
+
+// fromRowMajor returns an m-by-n matrix with entries given in row-major order.
+func fromRowMajor(m, n int, data []float64) Matrix {
+	A := make(Matrix, 0, m)
+	for i := 0; i < m; i++ {
+		A = append(A, vector.Vector(data[i*n:(i+1)*n:(i+1)*n]))
+	}
+
+	return A
+}
+
+// rowMajor returns the dimensions of a matrix and its entries in row-major
+// order.
+func (A Matrix) rowMajor() (int, int, []float64) {
+	if len(A) == 0 {
+		return 0, 0, nil
+	}
+
+	m, n := A.Dimensions()
+	data := make([]float64, 0, m*n)
+	for _, r := range A {
+		data = append(data, r...)
+	}
+
+	return m, n, data
+}
+
+// MarshalJSON encodes a matrix as a JSON array of rows, each encoded as by
+// vector.Vector.MarshalJSON.
+func (A Matrix) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]vector.Vector(A))
+}
+
+// UnmarshalJSON decodes a JSON array of rows into a matrix. Every row must have
+// the same length.
+func (A *Matrix) UnmarshalJSON(b []byte) error {
+	var rows []vector.Vector
+	if err := json.Unmarshal(b, &rows); err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		if len(r) != len(rows[0]) {
+			return fmt.Errorf("inconsistent matrix dimensions: %w", ErrDimensionMismatch)
+		}
+	}
+
+	*A = Matrix(rows)
+	return nil
+}
+
+// ReadCSV reads a matrix from CSV with one row per line. CSV cannot represent a
+// matrix with rows but no columns.
+func ReadCSV(r io.Reader) (Matrix, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	A := make(Matrix, 0, len(records))
+	for _, record := range records {
+		row := make(vector.Vector, 0, len(record))
+		for _, f := range record {
+			x, err := strconv.ParseFloat(f, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid matrix entry %q: %w", f, ErrFormat)
+			}
+
+			row = append(row, x)
+		}
+
+		A = append(A, row)
+	}
+
+	return A, nil
+}
+
+// WriteCSV writes a matrix as CSV with one row per line.
+func (A Matrix) WriteCSV(w io.Writer) error {
+	var (
+		m, n, data = A.rowMajor()
+		cw         = csv.NewWriter(w)
+	)
+
+	for i := 0; i < m; i++ {
+		record := make([]string, 0, n)
+		for _, x := range data[i*n : (i+1)*n] {
+			record = append(record, strconv.FormatFloat(x, 'g', -1, 64))
+		}
+
+		cw.Write(record)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadMatrixMarket reads a matrix in the MatrixMarket array or coordinate
+// format. Real, integer and pattern entries are accepted, as are general,
+// symmetric and skew-symmetric matrices. A matrix in the coordinate format may
+// have at most 2^20 entries, or eight for each byte of input if that is more,
+// since its dense result may otherwise be far larger than the input.
+func ReadMatrixMarket(r io.Reader) (Matrix, error) {
+	m, n, data, err := codec.ReadMatrixMarket(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromRowMajor(m, n, data), nil
+}
+
+// WriteMatrixMarket writes a matrix in the MatrixMarket format. The array format
+// lists every entry, while the coordinate format lists only the non-zero
+// entries, which is smaller for sparse matrices.
+func (A Matrix) WriteMatrixMarket(w io.Writer, coordinate bool) error {
+	m, n, data := A.rowMajor()
+	return codec.WriteMatrixMarket(w, m, n, data, coordinate)
+}
+
+// ReadNPY reads a matrix from a NumPy .npy file holding a two-dimensional array
+// of floats or integers in either storage order.
+func ReadNPY(r io.Reader) (Matrix, error) {
+	shape, data, err := codec.ReadNPY(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shape) != 2 {
+		return nil, fmt.Errorf("npy array has %d dimensions: %w", len(shape), ErrDimensionMismatch)
+	}
+
+	return fromRowMajor(shape[0], shape[1], data), nil
+}
+
+// WriteNPY writes a matrix as a two-dimensional float64 array in the NumPy .npy
+// format.
+func (A Matrix) WriteNPY(w io.Writer) error {
+	m, n, data := A.rowMajor()
+	return codec.WriteNPY(w, []int{m, n}, data)
+}
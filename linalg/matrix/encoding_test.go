@@ -0,0 +1,129 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	gomath "math"
+	"strings"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// identical returns true if two matrices have the same dimensions and entries,
+// bit for bit.
+func identical(A, B Matrix) bool {
+	if len(A) != len(B) {
+		return false
+	}
+
+	for i := range A {
+		if len(A[i]) != len(B[i]) {
+			return false
+		}
+
+		for j := range A[i] {
+			if gomath.Float64bits(A[i][j]) != gomath.Float64bits(B[i][j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestEncoding(t *testing.T) {
+	matrices := []Matrix{
+		{
+			vector.Vector{0.1, -2, 1e-310},
+			vector.Vector{gomath.MaxFloat64, gomath.Copysign(0, -1), gomath.Pi},
+		},
+		{
+			vector.Vector{gomath.Inf(1)},
+			vector.Vector{0},
+			vector.Vector{-1.5},
+		},
+		{},
+	}
+
+	tests := []struct {
+		name   string
+		encode func(Matrix, *bytes.Buffer) error
+		decode func(*bytes.Buffer) (Matrix, error)
+	}{
+		{
+			name: "json",
+			encode: func(A Matrix, b *bytes.Buffer) error {
+				return json.NewEncoder(b).Encode(A)
+			},
+			decode: func(b *bytes.Buffer) (Matrix, error) {
+				var A Matrix
+				err := json.NewDecoder(b).Decode(&A)
+				return A, err
+			},
+		},
+		{
+			name:   "csv",
+			encode: func(A Matrix, b *bytes.Buffer) error { return A.WriteCSV(b) },
+			decode: func(b *bytes.Buffer) (Matrix, error) { return ReadCSV(b) },
+		},
+		{
+			name:   "matrix market array",
+			encode: func(A Matrix, b *bytes.Buffer) error { return A.WriteMatrixMarket(b, false) },
+			decode: func(b *bytes.Buffer) (Matrix, error) { return ReadMatrixMarket(b) },
+		},
+		{
+			name:   "matrix market coordinate",
+			encode: func(A Matrix, b *bytes.Buffer) error { return A.WriteMatrixMarket(b, true) },
+			decode: func(b *bytes.Buffer) (Matrix, error) { return ReadMatrixMarket(b) },
+		},
+		{
+			name:   "npy",
+			encode: func(A Matrix, b *bytes.Buffer) error { return A.WriteNPY(b) },
+			decode: func(b *bytes.Buffer) (Matrix, error) { return ReadNPY(b) },
+		},
+	}
+
+	for _, test := range tests {
+		for _, exp := range matrices {
+			var b bytes.Buffer
+			if err := test.encode(exp, &b); err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			rec, err := test.decode(&b)
+			if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			if !identical(exp, rec) {
+				t.Fatalf("%s\nexpected %v\nreceived %v", test.name, exp, rec)
+			}
+		}
+	}
+}
+
+func TestDecodingErrors(t *testing.T) {
+	var A Matrix
+	if err := json.Unmarshal([]byte(`[[1,2],[3]]`), &A); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := ReadCSV(strings.NewReader("1,2\nx,4\n")); !errors.Is(err, ErrFormat) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrFormat, err)
+	}
+
+	var b bytes.Buffer
+	if err := (vector.Vector{1, 2, 3}).WriteNPY(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ReadNPY(&b); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := ReadMatrixMarket(strings.NewReader("%%MatrixMarket matrix array complex general\n1 1\n1 0\n")); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrUnsupported, err)
+	}
+}
@@ -0,0 +1,347 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+var (
+	// ErrDimensionMismatch is returned when matrices or vectors do not have
+	// compatible dimensions. It is the same error as
+	// vector.ErrDimensionMismatch.
+	ErrDimensionMismatch = vector.ErrDimensionMismatch
+
+	// ErrEmpty is returned when a matrix has no rows or no columns.
+	ErrEmpty = errors.New("matrix is empty")
+
+	// ErrNegativePower is returned when a matrix is raised to a power less
+	// than -1.
+	ErrNegativePower = errors.New("power must be non-negative, except for -1")
+
+	// ErrNotSquare is returned when a matrix must be square, but is not.
+	ErrNotSquare = errors.New("matrix is not square")
+
+	// ErrSingular is returned when a matrix has no inverse.
+	ErrSingular = errors.New("matrix is singular")
+)
+
+// ------------------------------------------------------------------------------
+// ERROR-RETURNING OPERATIONS ON MATRICES
+// ------------------------------------------------------------------------------
+// Each TryF returns an error where F would panic, so that matrices built from
+// untrusted input can be handled without recovering. The arguments are
+// unchanged when an error is returned.
+// ------------------------------------------------------------------------------
+
+// TryAdd returns the sum of two matrices.
+func TryAdd(A, B Matrix) (Matrix, error) {
+	if err := sameDimensions(A, B); err != nil {
+		return nil, err
+	}
+
+	return Add(A, B), nil
+}
+
+// TryAdd adds B to A.
+func (A Matrix) TryAdd(B Matrix) error {
+	if err := sameDimensions(A, B); err != nil {
+		return err
+	}
+
+	A.Add(B)
+	return nil
+}
+
+// TryAppendColumn returns a matrix that is the joining of a given matrix with a
+// column vector.
+func (A Matrix) TryAppendColumn(x vector.Vector) (Matrix, error) {
+	m, _, err := A.TryDimensions()
+	switch {
+	case err != nil:
+		return nil, err
+	case m != len(x):
+		return nil, ErrDimensionMismatch
+	}
+
+	return A.AppendColumn(x), nil
+}
+
+// TryAppendRow returns a matrix that is the joining of a given matrix with a row
+// vector.
+func (A Matrix) TryAppendRow(x vector.Vector) (Matrix, error) {
+	_, n, err := A.TryDimensions()
+	switch {
+	case err != nil:
+		return nil, err
+	case n != len(x):
+		return nil, ErrDimensionMismatch
+	}
+
+	return A.AppendRow(x), nil
+}
+
+// TryCompare returns -1, 0, 1 indicating A precedes, is equal to, or follows B.
+func (A Matrix) TryCompare(B Matrix) (int, error) {
+	if err := sameDimensions(A, B); err != nil {
+		return 0, err
+	}
+
+	return A.Compare(B), nil
+}
+
+// TryDeterminant returns the determinant of a square matrix.
+func (A Matrix) TryDeterminant() (float64, error) {
+	if err := A.square(); err != nil {
+		return 0, err
+	}
+
+	return A.Determinant(), nil
+}
+
+// TryDimensions returns the dimensions (number of rows, number of columns) of a
+// matrix.
+func (A Matrix) TryDimensions() (int, int, error) {
+	if len(A) == 0 || len(A[0]) == 0 {
+		return 0, 0, ErrEmpty
+	}
+
+	m, n := len(A), len(A[0])
+	for _, r := range A {
+		if n != len(r) {
+			return 0, 0, fmt.Errorf("inconsistent matrix dimensions: %w", ErrDimensionMismatch)
+		}
+	}
+
+	return m, n, nil
+}
+
+// TryHadamard returns the entry-wise product of two matrices.
+func TryHadamard(A, B Matrix) (Matrix, error) {
+	if err := sameDimensions(A, B); err != nil {
+		return nil, err
+	}
+
+	return Hadamard(A, B), nil
+}
+
+// TryHadamard multiplies each entry of A by the corresponding entry of B.
+func (A Matrix) TryHadamard(B Matrix) error {
+	if err := sameDimensions(A, B); err != nil {
+		return err
+	}
+
+	A.Hadamard(B)
+	return nil
+}
+
+// TryHadamardDivide returns the entry-wise quotient of two matrices.
+func TryHadamardDivide(A, B Matrix) (Matrix, error) {
+	if err := sameDimensions(A, B); err != nil {
+		return nil, err
+	}
+
+	return HadamardDivide(A, B), nil
+}
+
+// TryHadamardDivide divides each entry of A by the corresponding entry of B.
+func (A Matrix) TryHadamardDivide(B Matrix) error {
+	if err := sameDimensions(A, B); err != nil {
+		return err
+	}
+
+	A.HadamardDivide(B)
+	return nil
+}
+
+// TryHStack returns the matrix formed by placing the given matrices side by
+// side.
+func TryHStack(As ...Matrix) (Matrix, error) {
+	for _, A := range As {
+		m, _, err := A.TryDimensions()
+		switch {
+		case err != nil:
+			return nil, err
+		case m != len(As[0]):
+			return nil, ErrDimensionMismatch
+		}
+	}
+
+	return HStack(As...), nil
+}
+
+// TryInverse returns the inverse of a square matrix.
+func (A Matrix) TryInverse() (Matrix, error) {
+	if err := A.square(); err != nil {
+		return nil, err
+	}
+
+	return NewLU(A).Inverse()
+}
+
+// TryJoin returns a matrix that is the joining of two given matrices.
+func (A Matrix) TryJoin(B Matrix) (Matrix, error) {
+	ma, _, err := A.TryDimensions()
+	if err != nil {
+		return nil, err
+	}
+
+	mb, _, err := B.TryDimensions()
+	switch {
+	case err != nil:
+		return nil, err
+	case ma != mb:
+		return nil, ErrDimensionMismatch
+	}
+
+	return A.Join(B), nil
+}
+
+// TryList lists several vectors into a matrix.
+func TryList(vectors ...vector.Vector) (Matrix, error) {
+	for _, v := range vectors {
+		if len(v) != len(vectors[0]) {
+			return nil, ErrDimensionMismatch
+		}
+	}
+
+	return List(vectors...), nil
+}
+
+// TryMultiply multiplies several matrices.
+func TryMultiply(As ...Matrix) (Matrix, error) {
+	var prev int // Number of columns in the previous matrix
+	for i, A := range As {
+		m, n, err := A.TryDimensions()
+		switch {
+		case err != nil:
+			return nil, err
+		case 0 < i && prev != m:
+			return nil, ErrDimensionMismatch
+		}
+
+		prev = n
+	}
+
+	return Multiply(As...), nil
+}
+
+// TryPow returns A^p, for square matrix A and -1 <= p. If p = -1, the inverse
+// is returned.
+func TryPow(A Matrix, p int) (Matrix, error) {
+	if err := A.square(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p < -1:
+		return nil, ErrNegativePower
+	case p == -1:
+		return A.TryInverse()
+	}
+
+	return Pow(A, p), nil
+}
+
+// TrySolve solves Ax=y for x, for square matrix A.
+func (A Matrix) TrySolve(y vector.Vector) (vector.Vector, error) {
+	if err := A.square(); err != nil {
+		return nil, err
+	}
+
+	if len(A) != len(y) {
+		return nil, ErrDimensionMismatch
+	}
+
+	return NewLU(A).Solve(y)
+}
+
+// TrySubtract returns A-B.
+func TrySubtract(A, B Matrix) (Matrix, error) {
+	if err := sameDimensions(A, B); err != nil {
+		return nil, err
+	}
+
+	return Subtract(A, B), nil
+}
+
+// TrySubtract subtracts B from A.
+func (A Matrix) TrySubtract(B Matrix) error {
+	if err := sameDimensions(A, B); err != nil {
+		return err
+	}
+
+	A.Subtract(B)
+	return nil
+}
+
+// TryTrace traces the main or secondary diagonal.
+func (A Matrix) TryTrace(mainDiagonal bool) (float64, error) {
+	if err := A.square(); err != nil {
+		return 0, err
+	}
+
+	return A.Trace(mainDiagonal), nil
+}
+
+// TryVector converts a row or column matrix to a vector.
+func (A Matrix) TryVector() (vector.Vector, error) {
+	m, n, err := A.TryDimensions()
+	switch {
+	case err != nil:
+		return nil, err
+	case m != 1 && n != 1:
+		return nil, ErrDimensionMismatch
+	}
+
+	return A.Vector(), nil
+}
+
+// TryVStack returns the matrix formed by placing the given matrices one above
+// the next.
+func TryVStack(As ...Matrix) (Matrix, error) {
+	for _, A := range As {
+		_, n, err := A.TryDimensions()
+		switch {
+		case err != nil:
+			return nil, err
+		case n != len(As[0][0]):
+			return nil, ErrDimensionMismatch
+		}
+	}
+
+	return VStack(As...), nil
+}
+
+// sameDimensions returns an error if A and B are not both valid matrices of
+// the same dimensions.
+func sameDimensions(A, B Matrix) error {
+	ma, na, err := A.TryDimensions()
+	if err != nil {
+		return err
+	}
+
+	mb, nb, err := B.TryDimensions()
+	switch {
+	case err != nil:
+		return err
+	case ma != mb || na != nb:
+		return ErrDimensionMismatch
+	}
+
+	return nil
+}
+
+// square returns an error if A is not a valid square matrix.
+func (A Matrix) square() error {
+	m, n, err := A.TryDimensions()
+	switch {
+	case err != nil:
+		return err
+	case m != n:
+		return ErrNotSquare
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestTry(t *testing.T) {
+	var (
+		A      = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		B      = Matrix{vector.Vector{1, 2, 3}, vector.Vector{4, 5, 6}}
+		ragged = Matrix{vector.Vector{1, 2}, vector.Vector{3}}
+		sing   = Matrix{vector.Vector{1, 2}, vector.Vector{2, 4}}
+	)
+
+	if C, err := TryMultiply(A, B); err != nil || !C.Equals(Multiply(A, B)) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", Multiply(A, B), C, err)
+	}
+
+	if x, err := A.TrySolve(vector.Vector{5, 6}); err != nil || !x.Approx(vector.Vector{-4, 4.5}, 1e-12) {
+		t.Fatalf("\nexpected [-4 4.5]\nreceived %v (%v)", x, err)
+	}
+
+	tests := []struct {
+		f   func() error
+		exp error
+	}{
+		{f: func() error { _, _, err := ragged.TryDimensions(); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, _, err := Matrix{}.TryDimensions(); return err }, exp: ErrEmpty},
+		{f: func() error { _, err := TryAdd(A, B); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryAdd(A, ragged); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { return A.TrySubtract(B) }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryMultiply(B, A); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryList(vector.Vector{1}, vector.Vector{1, 2}); return err }, exp: vector.ErrDimensionMismatch},
+		{f: func() error { _, err := B.TryDeterminant(); return err }, exp: ErrNotSquare},
+		{f: func() error { _, err := B.TryTrace(true); return err }, exp: ErrNotSquare},
+		{f: func() error { _, err := TryPow(B, 2); return err }, exp: ErrNotSquare},
+		{f: func() error { _, err := TryPow(A, -2); return err }, exp: ErrNegativePower},
+		{f: func() error { _, err := TryPow(sing, -1); return err }, exp: ErrSingular},
+		{f: func() error { _, err := sing.TryInverse(); return err }, exp: ErrSingular},
+		{f: func() error { _, err := sing.TrySolve(vector.Vector{1, 2}); return err }, exp: ErrSingular},
+		{f: func() error { _, err := A.TrySolve(vector.Vector{1, 2, 3}); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := A.TryJoin(Matrix{vector.Vector{1}}); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := A.TryAppendRow(vector.Vector{1}); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := A.TryVector(); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryHadamard(A, B); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { return A.TryHadamardDivide(ragged) }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryHStack(A, Matrix{vector.Vector{1}}); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryVStack(A, B); return err }, exp: ErrDimensionMismatch},
+		{f: func() error { _, err := TryVStack(A, Matrix{}); return err }, exp: ErrEmpty},
+	}
+
+	for i, test := range tests {
+		if err := test.f(); !errors.Is(err, test.exp) {
+			t.Fatalf("\ntest %d\nexpected %v\nreceived %v", i, test.exp, err)
+		}
+	}
+
+	if !A.Equals(Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}) {
+		t.Fatalf("\nexpected A unchanged\nreceived %v", A)
+	}
+}
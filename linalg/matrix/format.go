@@ -0,0 +1,154 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nathangreene3/math/linalg/internal/format"
+)
+
+var (
+	// FormatThreshold is the number of entries above which a matrix is
+	// elided when formatted with Format.
+	FormatThreshold = 1000
+
+	// FormatEdgeItems is the number of rows and columns shown at each end of
+	// an elided matrix.
+	FormatEdgeItems = 3
+)
+
+// Format implements fmt.Formatter, printing one row per line with the entries
+// of each column aligned. The verbs f, F, e, E, g and G format each entry as
+// strconv.FormatFloat does, using the precision if one is given and the fewest
+// digits that represent the entry exactly otherwise. The verbs v and s are the
+// same as f, as in Matrix.String. For example, fmt.Printf("%.1f", A) prints
+//
+//	[[ 1.0 -2.5]
+//	 [10.0  0.0]]
+//
+// Each column is padded to the widest of its entries and the width, if one is
+// given, and the flags are
+//
+//	'+'	always print the sign of each entry
+//	'-'	pad entries on the right rather than the left
+//	'#'	print a LaTeX bmatrix
+//
+// A matrix of more than FormatThreshold entries is elided, showing only the
+// first and last FormatEdgeItems rows and columns, as NumPy does.
+func (A Matrix) Format(f fmt.State, verb rune) {
+	spec, ok := format.Verb(verb)
+	if !ok {
+		fmt.Fprintf(f, "%%!%c(matrix.Matrix=%s)", verb, A.String())
+		return
+	}
+
+	latex := f.Flag('#')
+	if len(A) == 0 {
+		if latex {
+			f.Write([]byte("\\begin{bmatrix}\n\\end{bmatrix}"))
+		} else {
+			f.Write([]byte("[]"))
+		}
+
+		return
+	}
+
+	// Entries of the grid are the formatted entries of A, except in elided
+	// rows and columns, which hold the ellipsis for that position.
+	var (
+		m, n         = A.Dimensions()
+		rows         = format.Elide(m, m*n, FormatThreshold, FormatEdgeItems)
+		cols         = format.Elide(n, m*n, FormatThreshold, FormatEdgeItems)
+		width, _     = f.Width()
+		widths       = make([]int, len(cols))
+		grid         = make([][]string, 0, len(rows))
+		hdots, vdots = "...", ""
+		ddots        string
+	)
+
+	if latex {
+		hdots, vdots, ddots = `\cdots`, `\vdots`, `\ddots`
+	}
+
+	for _, i := range rows {
+		row := make([]string, 0, len(cols))
+		for _, j := range cols {
+			var s string
+			switch {
+			case 0 <= i && 0 <= j:
+				s = format.Entry(f, spec, A[i][j])
+			case 0 <= i:
+				s = hdots
+			case 0 <= j:
+				s = vdots
+			default:
+				s = ddots
+			}
+
+			row = append(row, s)
+		}
+
+		grid = append(grid, row)
+	}
+
+	for k, j := range cols {
+		widths[k] = width
+		if j < 0 {
+			continue
+		}
+
+		for _, row := range grid {
+			if widths[k] < len(row[k]) {
+				widths[k] = len(row[k])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	if latex {
+		sb.WriteString("\\begin{bmatrix}\n")
+	} else {
+		sb.WriteByte('[')
+	}
+
+	for r, row := range grid {
+		if latex {
+			for k := range row {
+				row[k] = format.Pad(row[k], widths[k], f.Flag('-'))
+			}
+
+			sb.WriteString(strings.Join(row, " & "))
+			if r+1 < len(grid) {
+				sb.WriteString(` \\`)
+			}
+
+			sb.WriteByte('\n')
+			continue
+		}
+
+		if 0 < r {
+			sb.WriteString("\n ")
+		}
+
+		if rows[r] < 0 {
+			sb.WriteString("...")
+			continue
+		}
+
+		for k := range row {
+			row[k] = format.Pad(row[k], widths[k], f.Flag('-'))
+		}
+
+		sb.WriteByte('[')
+		sb.WriteString(strings.Join(row, " "))
+		sb.WriteByte(']')
+	}
+
+	if latex {
+		sb.WriteString(`\end{bmatrix}`)
+	} else {
+		sb.WriteByte(']')
+	}
+
+	f.Write([]byte(sb.String()))
+}
@@ -0,0 +1,59 @@
+package matrix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestFormat(t *testing.T) {
+	var (
+		A     = Matrix{vector.Vector{1, -2.5}, vector.Vector{10, 0}}
+		large = New(40, 40, func(i, j int) float64 { return float64(i - j) })
+		tests = []struct {
+			format string
+			A      Matrix
+			exp    string
+		}{
+			{format: "%v", A: A, exp: "[[ 1 -2.5]\n [10    0]]"},
+			{format: "%.1f", A: A, exp: "[[ 1.0 -2.5]\n [10.0  0.0]]"},
+			{format: "%+v", A: A, exp: "[[ +1 -2.5]\n [+10   +0]]"},
+			{format: "%5v", A: A, exp: "[[    1  -2.5]\n [   10     0]]"},
+			{format: "%-v", A: A, exp: "[[1  -2.5]\n [10 0   ]]"},
+			{format: "%#.1f", A: A, exp: "\\begin{bmatrix}\n 1.0 & -2.5 \\\\\n10.0 &  0.0\n\\end{bmatrix}"},
+			{format: "%d", A: A, exp: "%!d(matrix.Matrix=[[1 -2.5],[10 0]])"},
+			{format: "%v", A: Matrix{}, exp: "[]"},
+			{
+				format: "%v",
+				A:      large,
+				exp: "[[ 0 -1 -2 ... -37 -38 -39]\n" +
+					" [ 1  0 -1 ... -36 -37 -38]\n" +
+					" [ 2  1  0 ... -35 -36 -37]\n" +
+					" ...\n" +
+					" [37 36 35 ...   0  -1  -2]\n" +
+					" [38 37 36 ...   1   0  -1]\n" +
+					" [39 38 37 ...   2   1   0]]",
+			},
+			{
+				format: "%#v",
+				A:      New(501, 2, func(i, j int) float64 { return float64(2*i + j) }),
+				exp: "\\begin{bmatrix}\n" +
+					"     0 &      1 \\\\\n" +
+					"     2 &      3 \\\\\n" +
+					"     4 &      5 \\\\\n" +
+					"\\vdots & \\vdots \\\\\n" +
+					"   996 &    997 \\\\\n" +
+					"   998 &    999 \\\\\n" +
+					"  1000 &   1001\n" +
+					"\\end{bmatrix}",
+			},
+		}
+	)
+
+	for _, test := range tests {
+		if rec := fmt.Sprintf(test.format, test.A); test.exp != rec {
+			t.Fatalf("\nexpected %q\nreceived %q", test.exp, rec)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	gomath "math"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The matrix functions defined here follow Matrix Computations, 4th Ed., by
+// Gene H. Golub and Charles F. Van Loan, and Functions of Matrices: Theory and
+// Computation, by Nicholas J. Higham.
+// ------------------------------------------------------------------------------
+
+// padeDegree is the degree of the diagonal Padé approximant used by Exp. With
+// the scaling Exp chooses, degree six is accurate to double precision.
+const padeDegree = 6
+
+// Exp returns e^A, for square matrix A, by scaling and squaring with a
+// diagonal Padé approximant (Golub and Van Loan, Algorithm 9.3.1).
+func Exp(A Matrix) Matrix {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	// Scale A by 2^-s so that its norm is at most 1/2, so the approximant is
+	// accurate, then undo the scaling with e^A = (e^(A/2^s))^(2^s).
+	var s int
+	if norm := A.InfNorm(); 0 < norm {
+		_, e := gomath.Frexp(norm)
+		if s = e + 1; s < 0 {
+			s = 0
+		}
+	}
+
+	var (
+		B    = ScalarMultiply(gomath.Ldexp(1, -s), A)
+		X    = B.Copy()
+		c    = 0.5
+		N    = Add(Identity(n, n), ScalarMultiply(c, B))
+		D    = Subtract(Identity(n, n), ScalarMultiply(c, B))
+		even = true
+	)
+
+	for k := 2; k <= padeDegree; k++ {
+		c *= float64(padeDegree-k+1) / float64(k*(2*padeDegree-k+1))
+		X = Multiply(B, X)
+		cX := ScalarMultiply(c, X)
+		N.Add(cX)
+		if even {
+			D.Add(cX)
+		} else {
+			D.Subtract(cX)
+		}
+
+		even = !even
+	}
+
+	// D is well conditioned for the scaled matrix, so it is never singular.
+	E, err := NewLU(D).SolveMatrix(N)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	for ; 0 < s; s-- {
+		E = Multiply(E, E)
+	}
+
+	return E
+}
+
+// Log returns the principal logarithm of a square matrix A, which is the
+// matrix X with e^X = A whose eigenvalues have imaginary parts in (-pi,pi).
+// It exists when A has no eigenvalues on the closed negative real axis. The
+// inverse scaling and squaring method is used: square roots are taken until A
+// is close to the identity, where a series converges quickly.
+func Log(A Matrix) (Matrix, error) {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	var (
+		B = A.Copy()
+		I = Identity(n, n)
+		k int
+	)
+
+	for ; 0.25 < Subtract(B, I).InfNorm(); k++ {
+		if k == maxEigenIters {
+			return nil, ErrNoConvergence
+		}
+
+		var err error
+		if B, err = Sqrt(B); err != nil {
+			return nil, err
+		}
+	}
+
+	// log(B) = 2 atanh(Z) = 2(Z + Z^3/3 + Z^5/5 + ...), where
+	// Z = (B+I)^-1(B-I) has norm about 1/8. The factors commute, so the order
+	// of the product does not matter.
+	Z, err := NewLU(Add(B, I)).SolveMatrix(Subtract(B, I))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		Z2  = Multiply(Z, Z)
+		P   = Z.Copy()
+		Sum = Z.Copy()
+	)
+
+	for j := 3; ; j += 2 {
+		P = Multiply(P, Z2)
+		T := ScalarDivide(float64(j), P)
+		Sum.Add(T)
+		if T.InfNorm() <= epsilon*Sum.InfNorm() {
+			break
+		}
+
+		if j > 2*maxEigenIters {
+			return nil, ErrNoConvergence
+		}
+	}
+
+	Sum.ScalarMultiply(gomath.Ldexp(2, k))
+	return Sum, nil
+}
+
+// Sqrt returns the principal square root of a square matrix A, which is the
+// matrix X with X^2 = A whose eigenvalues have positive real parts. It exists
+// when A has no eigenvalues on the closed negative real axis. The Denman-Beavers
+// iteration is used (Higham, eq. 6.15).
+func Sqrt(A Matrix) (Matrix, error) {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	// Y converges to A^(1/2) and Z converges to A^(-1/2).
+	Y, Z := A.Copy(), Identity(n, n)
+	for k := 0; k < maxEigenIters; k++ {
+		Yinv, err := NewLU(Y).Inverse()
+		if err != nil {
+			return nil, err
+		}
+
+		Zinv, err := NewLU(Z).Inverse()
+		if err != nil {
+			return nil, err
+		}
+
+		Ynext := ScalarMultiply(0.5, Add(Y, Zinv))
+		Z = ScalarMultiply(0.5, Add(Z, Yinv))
+		diff := Subtract(Ynext, Y).InfNorm()
+		Y = Ynext
+		if diff <= float64(n)*epsilon*Y.InfNorm() {
+			return Y, nil
+		}
+	}
+
+	return nil, ErrNoConvergence
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// rotation returns the matrix rotating the plane by t radians.
+func rotation(t float64) Matrix {
+	s, c := gomath.Sincos(t)
+	return Matrix{vector.Vector{c, -s}, vector.Vector{s, c}}
+}
+
+// generator returns the infinitesimal generator of rotation(t).
+func generator(t float64) Matrix {
+	return Matrix{vector.Vector{0, -t}, vector.Vector{t, 0}}
+}
+
+func TestExp(t *testing.T) {
+	tests := []struct {
+		A, exp Matrix
+	}{
+		{
+			A:   Empty(3, 3),
+			exp: Identity(3, 3),
+		},
+		{
+			A:   Matrix{vector.Vector{1, 0}, vector.Vector{0, 2}},
+			exp: Matrix{vector.Vector{gomath.E, 0}, vector.Vector{0, gomath.E * gomath.E}},
+		},
+		{
+			// Nilpotent, so the series terminates
+			A:   Matrix{vector.Vector{0, 1}, vector.Vector{0, 0}},
+			exp: Matrix{vector.Vector{1, 1}, vector.Vector{0, 1}},
+		},
+		{
+			A:   generator(gomath.Pi / 3),
+			exp: rotation(gomath.Pi / 3),
+		},
+		{
+			// Large norm requires many squarings
+			A:   generator(20),
+			exp: rotation(20),
+		},
+	}
+
+	for _, test := range tests {
+		if rec := Exp(test.A); !rec.Approx(test.exp, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	tests := []struct {
+		A, exp Matrix
+	}{
+		{
+			A:   Identity(3, 3),
+			exp: Empty(3, 3),
+		},
+		{
+			A:   Matrix{vector.Vector{gomath.E, 0}, vector.Vector{0, 100}},
+			exp: Matrix{vector.Vector{1, 0}, vector.Vector{0, gomath.Log(100)}},
+		},
+		{
+			A:   rotation(2 * gomath.Pi / 3),
+			exp: generator(2 * gomath.Pi / 3),
+		},
+		{
+			A:   Matrix{vector.Vector{1, 1}, vector.Vector{0, 1}},
+			exp: Matrix{vector.Vector{0, 1}, vector.Vector{0, 0}},
+		},
+	}
+
+	for _, test := range tests {
+		rec, err := Log(test.A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !rec.Approx(test.exp, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+
+	A := Matrix{
+		vector.Vector{4, 1, 0},
+		vector.Vector{1, 3, 1},
+		vector.Vector{0, 1, 2},
+	}
+
+	L, err := Log(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rec := Exp(L); !rec.Approx(A, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, rec)
+	}
+
+	if _, err := Log(Empty(2, 2)); err != ErrSingular {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		A, exp Matrix
+	}{
+		{
+			A:   Matrix{vector.Vector{4, 0}, vector.Vector{0, 9}},
+			exp: Matrix{vector.Vector{2, 0}, vector.Vector{0, 3}},
+		},
+		{
+			A:   rotation(gomath.Pi / 2),
+			exp: rotation(gomath.Pi / 4),
+		},
+		{
+			A:   Matrix{vector.Vector{1, 2}, vector.Vector{0, 1}},
+			exp: Matrix{vector.Vector{1, 1}, vector.Vector{0, 1}},
+		},
+	}
+
+	for _, test := range tests {
+		rec, err := Sqrt(test.A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !rec.Approx(test.exp, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+
+	A := Matrix{
+		vector.Vector{4, 12, -16},
+		vector.Vector{12, 37, -43},
+		vector.Vector{-16, -43, 98},
+	}
+
+	X, err := Sqrt(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rec := Multiply(X, X); !rec.Approx(A, 1e-9) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, rec)
+	}
+
+	if _, err := Sqrt(Matrix{vector.Vector{0, 1}, vector.Vector{0, 0}}); err != ErrSingular {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+	}
+}
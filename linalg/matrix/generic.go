@@ -0,0 +1,463 @@
+package matrix
+
+import "github.com/nathangreene3/math/linalg/vector"
+
+// ------------------------------------------------------------------------------
+// GENERIC MATRICES
+// ------------------------------------------------------------------------------
+// Of[T] provides the arithmetic of Matrix for any numeric entry type, with the
+// same method names and signatures: A.F(B) updates A and FOf(A,B) returns a new
+// matrix. Matrix is a separate type, not an alias of Of[float64], but converts
+// to and from it without copying entries by Generic and Float64.
+//
+// Algorithms that need more than ring arithmetic are functions constrained to
+// the types they are defined for: DeterminantOf for signed integers (exactly)
+// and floats, and InverseOf and SolveOf for floats. Negative powers need an
+// inverse, so PowOf accepts only non-negative ones. Factorizations (LU, QR,
+// Cholesky, eigen and singular value decompositions), norms, matrix functions
+// such as Exp, and row reduction are defined only on Matrix.
+// ------------------------------------------------------------------------------
+
+// Of is a matrix with entries of type T.
+type Of[T vector.Number] []vector.Of[T]
+
+// NewOf generates an m-by-n matrix with entries defined by a generating
+// function f.
+func NewOf[T vector.Number](m, n int, f func(i, j int) T) Of[T] {
+	A := make(Of[T], 0, m)
+	for i := 0; i < m; i++ {
+		A = append(A, vector.NewOf(n, func(j int) T { return f(i, j) }))
+	}
+
+	return A
+}
+
+// DeterminantOf returns the determinant of a square matrix by Bareiss's
+// fraction-free elimination with partial pivoting. Every division is exact for
+// integer matrices, so their determinants are exact unless an intermediate
+// value, which is a minor of A, overflows T.
+func DeterminantOf[T vector.Signed](A Of[T]) T {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("cannot take determinant of a non-square matrix")
+	}
+
+	var (
+		B         = A.Copy()
+		det, prev = T(1), T(1)
+		abs       = func(x T) T {
+			if x < 0 {
+				return -x
+			}
+			return x
+		}
+	)
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if abs(B[p][k]) < abs(B[i][k]) {
+				p = i
+			}
+		}
+
+		if B[p][k] == 0 {
+			return 0
+		}
+
+		if p != k {
+			B[p], B[k] = B[k], B[p]
+			det = -det
+		}
+
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				B[i][j] = (B[i][j]*B[k][k] - B[i][k]*B[k][j]) / prev
+			}
+		}
+
+		prev = B[k][k]
+	}
+
+	return det * B[n-1][n-1]
+}
+
+// EmptyOf returns an m-by-n matrix with zeroes for all entries.
+func EmptyOf[T vector.Number](m, n int) Of[T] {
+	A := make(Of[T], 0, m)
+	for i := 0; i < m; i++ {
+		A = append(A, vector.ZeroOf[T](n))
+	}
+
+	return A
+}
+
+// IdentityOf returns the m-by-n identity matrix.
+func IdentityOf[T vector.Number](m, n int) Of[T] {
+	A := EmptyOf[T](m, n)
+	for i := 0; i < m && i < n; i++ {
+		A[i][i] = 1
+	}
+
+	return A
+}
+
+// Float64 returns A as a Matrix. The two share their entries.
+func Float64(A Of[float64]) Matrix {
+	B := make(Matrix, 0, len(A))
+	for _, r := range A {
+		B = append(B, vector.Vector(r))
+	}
+
+	return B
+}
+
+// Generic returns A as an Of[float64]. The two share their entries.
+func (A Matrix) Generic() Of[float64] {
+	B := make(Of[float64], 0, len(A))
+	for _, r := range A {
+		B = append(B, vector.Of[float64](r))
+	}
+
+	return B
+}
+
+// AddOf returns the sum of two matrices.
+func AddOf[T vector.Number](A, B Of[T]) Of[T] {
+	C := A.Copy()
+	C.Add(B)
+	return C
+}
+
+// Add B to A.
+func (A Of[T]) Add(B Of[T]) {
+	A.sameShape(B)
+	for i, r := range A {
+		r.Add(B[i])
+	}
+}
+
+// Convert returns a matrix with the entries of A converted to type U, as by
+// the conversion U(A[i][j]).
+func Convert[U, T vector.Real](A Of[T]) Of[U] {
+	B := make(Of[U], 0, len(A))
+	for _, r := range A {
+		B = append(B, vector.Convert[U](r))
+	}
+
+	return B
+}
+
+// Copy a matrix.
+func (A Of[T]) Copy() Of[T] {
+	B := make(Of[T], 0, len(A))
+	for _, r := range A {
+		B = append(B, r.Copy())
+	}
+
+	return B
+}
+
+// Dimensions returns the dimensions (number of rows, number of columns) of a
+// matrix. A matrix without rows has no columns.
+func (A Of[T]) Dimensions() (int, int) {
+	if len(A) == 0 {
+		return 0, 0
+	}
+
+	m, n := len(A), len(A[0])
+	for _, r := range A {
+		if n != len(r) {
+			panic("inconsistent matrix dimensions")
+		}
+	}
+
+	return m, n
+}
+
+// Equals returns true if two matrices are equal in dimension and for each
+// entry. Otherwise, it returns false.
+func (A Of[T]) Equals(B Of[T]) bool {
+	if len(A) != len(B) {
+		return false
+	}
+
+	for i, r := range A {
+		if !r.Equal(B[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MultiplyOf returns the product of several matrices, multiplied from left to
+// right.
+func MultiplyOf[T vector.Number](As ...Of[T]) Of[T] {
+	if len(As) == 0 {
+		return nil
+	}
+
+	C := As[0]
+	for _, B := range As[1:] {
+		C = C.multiply(B)
+	}
+
+	return C
+}
+
+// multiply returns AB.
+func (A Of[T]) multiply(B Of[T]) Of[T] {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	// Accumulating whole rows of B keeps the inner loop on contiguous
+	// memory.
+	C := EmptyOf[T](ma, nb)
+	for i, r := range A {
+		for k, a := range r {
+			for j, b := range B[k] {
+				C[i][j] += a * b
+			}
+		}
+	}
+
+	return C
+}
+
+// MultiplyVectorOf returns Ax.
+func MultiplyVectorOf[T vector.Number](A Of[T], x vector.Of[T]) vector.Of[T] {
+	_, n := A.Dimensions()
+	if n != len(x) {
+		panic("dimension mismatch")
+	}
+
+	y := make(vector.Of[T], 0, len(A))
+	for _, r := range A {
+		y = append(y, r.Dot(x))
+	}
+
+	return y
+}
+
+// PowOf returns A^p, for square matrix A and 0 <= p, by repeated squaring. For
+// floating-point matrices, A^-1 is given by InverseOf.
+func PowOf[T vector.Number](A Of[T], p int) Of[T] {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	if p < 0 {
+		panic("power must be non-negative")
+	}
+
+	B, C := A.Copy(), IdentityOf[T](n, n)
+	for ; 0 < p; p >>= 1 {
+		if p&1 == 1 {
+			C = C.multiply(B)
+		}
+
+		if 1 < p {
+			B = B.multiply(B)
+		}
+	}
+
+	return C
+}
+
+// ScalarMultiplyOf returns aA.
+func ScalarMultiplyOf[T vector.Number](a T, A Of[T]) Of[T] {
+	B := A.Copy()
+	B.ScalarMultiply(a)
+	return B
+}
+
+// ScalarMultiply multiplies each entry of A by a.
+func (A Of[T]) ScalarMultiply(a T) {
+	for _, r := range A {
+		r.Multiply(a)
+	}
+}
+
+// sameShape panics if A and B do not have the same dimensions.
+func (A Of[T]) sameShape(B Of[T]) {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		panic("matrices must have the same dimensions")
+	}
+}
+
+// SubtractOf returns A-B.
+func SubtractOf[T vector.Number](A, B Of[T]) Of[T] {
+	C := A.Copy()
+	C.Subtract(B)
+	return C
+}
+
+// Subtract B from A.
+func (A Of[T]) Subtract(B Of[T]) {
+	A.sameShape(B)
+	for i, r := range A {
+		r.Subtract(B[i])
+	}
+}
+
+// Trace returns the sum of the main diagonal if mainDiagonal is true, or the
+// sum of the anti-diagonal otherwise, of a square matrix.
+func (A Of[T]) Trace(mainDiagonal bool) T {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("invalid dimensions")
+	}
+
+	var s T
+	if mainDiagonal {
+		for i, r := range A {
+			s += r[i]
+		}
+	} else {
+		for i, r := range A {
+			s += r[n-i-1]
+		}
+	}
+
+	return s
+}
+
+// Transpose returns the transpose of a matrix.
+func (A Of[T]) Transpose() Of[T] {
+	m, n := A.Dimensions()
+	return NewOf(n, m, func(i, j int) T { return A[j][i] })
+}
+
+// InverseOf returns the inverse of a square matrix. If A is singular,
+// ErrSingular is returned.
+func InverseOf[T vector.Float](A Of[T]) (Of[T], error) {
+	n, _ := A.Dimensions()
+	return solveOf(A, IdentityOf[T](n, n))
+}
+
+// SolveOf solves Ax=y for x, for square matrix A. If A is singular, ErrSingular
+// is returned.
+func SolveOf[T vector.Float](A Of[T], y vector.Of[T]) (vector.Of[T], error) {
+	Y := make(Of[T], 0, len(y))
+	for _, a := range y {
+		Y = append(Y, vector.Of[T]{a})
+	}
+
+	X, err := solveOf(A, Y)
+	if err != nil {
+		return nil, err
+	}
+
+	x := make(vector.Of[T], 0, len(X))
+	for _, r := range X {
+		x = append(x, r[0])
+	}
+
+	return x, nil
+}
+
+// epsilonOf returns the machine epsilon of T, which is the distance from one to
+// the next larger value of T.
+func epsilonOf[T vector.Float]() T {
+	eps := T(1)
+	for one := T(1); one+eps/2 != one; {
+		eps /= 2
+	}
+
+	return eps
+}
+
+// solveOf solves AX=B for X by Gaussian elimination with partial pivoting. As
+// in NewLU, A is singular if a pivot is no larger than n*eps*s, where eps is the
+// machine epsilon of T and s is the smaller of the largest absolute entries in
+// the pivot's row and column of A.
+func solveOf[T vector.Float](A, B Of[T]) (Of[T], error) {
+	m, n := A.Dimensions()
+	switch {
+	case m != n:
+		panic("matrix must be square")
+	case len(B) != n:
+		panic("dimension mismatch")
+	}
+
+	var (
+		eps = epsilonOf[T]()
+		abs = func(x T) T {
+			if x < 0 {
+				return -x
+			}
+			return x
+		}
+	)
+
+	// rowMax is permuted with the rows of A, so rowMax[k] belongs to the row
+	// in position k.
+	rowMax, colMax := vector.ZeroOf[T](n), vector.ZeroOf[T](n)
+	for i, r := range A {
+		for j, a := range r {
+			if a = abs(a); rowMax[i] < a {
+				rowMax[i] = a
+			}
+
+			if colMax[j] < a {
+				colMax[j] = a
+			}
+		}
+	}
+
+	// Rows of A and X are swapped together, so X ends as the solution.
+	U, X := A.Copy(), B.Copy()
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if abs(U[p][k]) < abs(U[i][k]) {
+				p = i
+			}
+		}
+
+		U[p], U[k] = U[k], U[p]
+		X[p], X[k] = X[k], X[p]
+		rowMax[p], rowMax[k] = rowMax[k], rowMax[p]
+		s := rowMax[k]
+		if colMax[k] < s {
+			s = colMax[k]
+		}
+
+		if abs(U[k][k]) <= T(n)*eps*s {
+			return nil, ErrSingular
+		}
+
+		for i := k + 1; i < n; i++ {
+			f := U[i][k] / U[k][k]
+			for j := k + 1; j < n; j++ {
+				U[i][j] -= f * U[k][j]
+			}
+
+			for j := range X[i] {
+				X[i][j] -= f * X[k][j]
+			}
+		}
+	}
+
+	for i := n - 1; 0 <= i; i-- {
+		for k := i + 1; k < n; k++ {
+			for j := range X[i] {
+				X[i][j] -= U[i][k] * X[k][j]
+			}
+		}
+
+		for j := range X[i] {
+			X[i][j] /= U[i][i]
+		}
+	}
+
+	return X, nil
+}
@@ -0,0 +1,169 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestOf(t *testing.T) {
+	// The n-th power of the Fibonacci matrix holds Fibonacci numbers.
+	var (
+		F   = Of[int]{vector.Of[int]{1, 1}, vector.Of[int]{1, 0}}
+		n   = 40
+		exp = Of[int]{
+			vector.Of[int]{math.Fibonacci(n), math.Fibonacci(n - 1)},
+			vector.Of[int]{math.Fibonacci(n - 1), math.Fibonacci(n - 2)},
+		}
+	)
+
+	if rec := PowOf(F, n); !exp.Equals(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// W is i times the identity.
+	var (
+		Z = Of[complex128]{vector.Of[complex128]{1i, 2}, vector.Of[complex128]{0, 1 - 1i}}
+		W = Of[complex128]{vector.Of[complex128]{1i, 0}, vector.Of[complex128]{0, 1i}}
+	)
+
+	expZ := Of[complex128]{vector.Of[complex128]{-1, 2i}, vector.Of[complex128]{0, 1 + 1i}}
+	if rec := MultiplyOf(Z, W); !expZ.Equals(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", expZ, rec)
+	}
+
+	if exp, rec := 1+0i, Z.Trace(true); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	var (
+		A = Of[float32]{vector.Of[float32]{1, 2, 3}, vector.Of[float32]{4, 5, 6}}
+		B = Convert[float32](Of[int]{vector.Of[int]{1, 2}, vector.Of[int]{3, 4}, vector.Of[int]{5, 6}})
+		C = Of[float32]{vector.Of[float32]{22, 28}, vector.Of[float32]{49, 64}}
+	)
+
+	if rec := MultiplyOf(A, B); !C.Equals(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", C, rec)
+	}
+
+	if rec := MultiplyOf(B.Transpose(), A.Transpose()); !C.Transpose().Equals(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", C.Transpose(), rec)
+	}
+
+	if exp, rec := (vector.Of[float32]{6, 15}), MultiplyVectorOf(A, vector.Of[float32]{1, 1, 1}); !exp.Equal(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// The float64 types convert without copying and agree with each other.
+	var (
+		X = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		Y = X.Generic()
+	)
+
+	if exp, rec := Multiply(X, X), Float64(MultiplyOf(Y, Y)); !exp.Equals(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	Y.ScalarMultiply(2)
+	if exp := (Matrix{vector.Vector{2, 4}, vector.Vector{6, 8}}); !exp.Equals(X) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, X)
+	}
+}
+
+func TestDeterminantOf(t *testing.T) {
+	A := Of[int64]{
+		vector.Of[int64]{2, -3, 1, 5},
+		vector.Of[int64]{4, 0, -2, 1},
+		vector.Of[int64]{-1, 7, 3, 0},
+		vector.Of[int64]{6, 2, 8, -4},
+	}
+
+	if exp, rec := int64(-1806), DeterminantOf(A); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d", exp, rec)
+	}
+
+	if rec := DeterminantOf(Of[int]{vector.Of[int]{1, 2}, vector.Of[int]{2, 4}}); rec != 0 {
+		t.Fatalf("\nexpected %d\nreceived %d", 0, rec)
+	}
+
+	if rec := DeterminantOf(Of[int]{vector.Of[int]{0, 1}, vector.Of[int]{1, 0}}); rec != -1 {
+		t.Fatalf("\nexpected %d\nreceived %d", -1, rec)
+	}
+
+	if rec := DeterminantOf(Of[float32]{vector.Of[float32]{0.5, 1}, vector.Of[float32]{2, 8}}); rec != 2 {
+		t.Fatalf("\nexpected %v\nreceived %v", 2, rec)
+	}
+}
+
+func TestSolveOf(t *testing.T) {
+	var (
+		A = Of[float32]{
+			vector.Of[float32]{2, 1, 1},
+			vector.Of[float32]{4, -6, 0},
+			vector.Of[float32]{-2, 7, 2},
+		}
+		x = vector.Of[float32]{1, 2, -1}
+		y = MultiplyOf(A, Of[float32]{{1}, {2}, {-1}})
+	)
+
+	rec, err := SolveOf(A, vector.Of[float32]{y[0][0], y[1][0], y[2][0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range x {
+		if d := rec[i] - x[i]; d < -1e-5 || 1e-5 < d {
+			t.Fatalf("\nexpected %v\nreceived %v", x, rec)
+		}
+	}
+
+	inv, err := InverseOf(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, r := range MultiplyOf(A, inv) {
+		for j, a := range r {
+			if d := a - IdentityOf[float32](3, 3)[i][j]; d < -1e-5 || 1e-5 < d {
+				t.Fatalf("\nexpected the identity\nreceived %v", MultiplyOf(A, inv))
+			}
+		}
+	}
+
+	singular := Of[float32]{vector.Of[float32]{1, 2, 3}, vector.Of[float32]{4, 5, 6}, vector.Of[float32]{7, 8, 9}}
+	if _, err := InverseOf(singular); err != ErrSingular {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+	}
+
+	// Badly scaled, but not singular
+	scaled := Of[float32]{vector.Of[float32]{1e20, 0}, vector.Of[float32]{0, 1}}
+	if rec, err := SolveOf(scaled, vector.Of[float32]{1e20, 2}); err != nil || !rec.Equal(vector.Of[float32]{1, 2}) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", vector.Of[float32]{1, 2}, rec, err)
+	}
+}
+
+func TestEpsilonOf(t *testing.T) {
+	if rec := epsilonOf[float32](); rec != 0x1p-23 {
+		t.Fatalf("\nexpected %v\nreceived %v", float32(0x1p-23), rec)
+	}
+
+	if rec := epsilonOf[float64](); rec != epsilon {
+		t.Fatalf("\nexpected %v\nreceived %v", epsilon, rec)
+	}
+}
+
+func TestTraceOf(t *testing.T) {
+	A := Of[int]{vector.Of[int]{1, 2}, vector.Of[int]{3, 5}}
+	if rec := A.Trace(true); rec != 6 {
+		t.Fatalf("\nexpected %d\nreceived %d", 6, rec)
+	}
+
+	if rec := A.Trace(false); rec != 5 {
+		t.Fatalf("\nexpected %d\nreceived %d", 5, rec)
+	}
+
+	if exp, rec := Float64(Convert[float64](A)).Trace(false), float64(A.Trace(false)); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
@@ -0,0 +1,148 @@
+package matrix
+
+import "github.com/nathangreene3/math/linalg/vector"
+
+// Interface is a read-only m-by-n matrix. The dimensions are named as they are
+// on Matrix, so Matrix, *Dense, sparse and structured matrices all implement
+// it without adaptation.
+type Interface interface {
+	At(i, j int) float64
+	Dimensions() (int, int)
+}
+
+// Setter is a matrix whose entries may be assigned.
+type Setter interface {
+	Set(i, j int, a float64)
+}
+
+// RowViewer is a matrix whose rows are stored contiguously and may be read
+// without copying.
+type RowViewer interface {
+	RowView(i int) vector.Vector
+}
+
+// Generated is an m-by-n matrix whose entries are computed by a generating
+// function each time they are read rather than stored.
+type Generated struct {
+	m, n int
+	f    Generator
+}
+
+// Generate returns a lazy m-by-n matrix with entries defined by a generating
+// function f.
+func Generate(m, n int, f Generator) *Generated {
+	if m < 0 || n < 0 {
+		panic("dimensions must be non-negative")
+	}
+
+	return &Generated{m: m, n: n, f: f}
+}
+
+// At returns the (i,j)th entry.
+func (G *Generated) At(i, j int) float64 {
+	if i < 0 || G.m <= i || j < 0 || G.n <= j {
+		panic("index out of range")
+	}
+
+	return G.f(i, j)
+}
+
+// Dimensions returns the dimensions of G.
+func (G *Generated) Dimensions() (int, int) {
+	return G.m, G.n
+}
+
+// Materialize returns a copy of A as a Matrix.
+func Materialize(A Interface) Matrix {
+	m, n := A.Dimensions()
+	B := make(Matrix, 0, m)
+	if R, ok := A.(RowViewer); ok {
+		for i := 0; i < m; i++ {
+			B = append(B, R.RowView(i).Copy())
+		}
+
+		return B
+	}
+
+	for i := 0; i < m; i++ {
+		r := make(vector.Vector, 0, n)
+		for j := 0; j < n; j++ {
+			r = append(r, A.At(i, j))
+		}
+
+		B = append(B, r)
+	}
+
+	return B
+}
+
+// asMatrix returns A as a Matrix for reading only. Rows of a RowViewer are
+// shared rather than copied.
+func asMatrix(A Interface) Matrix {
+	switch B := A.(type) {
+	case Matrix:
+		return B
+	case RowViewer:
+		m, _ := A.Dimensions()
+		C := make(Matrix, 0, m)
+		for i := 0; i < m; i++ {
+			C = append(C, B.RowView(i))
+		}
+
+		return C
+	default:
+		return Materialize(A)
+	}
+}
+
+// MultiplyVector returns Ax.
+func MultiplyVector(A Interface, x vector.Vector) vector.Vector {
+	m, n := A.Dimensions()
+	if n != len(x) {
+		panic("dimension mismatch")
+	}
+
+	y := vector.Zero(m)
+	if R, ok := A.(RowViewer); ok {
+		for i := 0; i < m; i++ {
+			y[i] = R.RowView(i).Dot(x)
+		}
+
+		return y
+	}
+
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			y[i] += A.At(i, j) * x[j]
+		}
+	}
+
+	return y
+}
+
+// Product returns the product of several matrices of any implementation. See
+// Multiply for details.
+func Product(As ...Interface) Matrix {
+	Bs := make([]Matrix, 0, len(As))
+	for _, A := range As {
+		Bs = append(Bs, asMatrix(A))
+	}
+
+	return Multiply(Bs...)
+}
+
+// RowView returns the ith row of A. It shares storage with A.
+func (A Matrix) RowView(i int) vector.Vector {
+	return A[i]
+}
+
+// Set the (i,j)th entry to a.
+func (A Matrix) Set(i, j int, a float64) {
+	A[i][j] = a
+}
+
+// Solve Ax=y for x, where A is square. If A is singular, ErrSingular is
+// returned.
+func Solve(A Interface, y vector.Vector) (vector.Vector, error) {
+	return NewLU(A).Solve(y)
+}
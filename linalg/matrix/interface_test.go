@@ -0,0 +1,110 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestGenerate(t *testing.T) {
+	var calls int
+	G := Generate(1000, 1000, func(i, j int) float64 {
+		calls++
+		return float64(i - j)
+	})
+
+	if calls != 0 {
+		t.Fatalf("\nexpected %d\nreceived %d", 0, calls)
+	}
+
+	if rec := G.At(3, 1); rec != 2 || calls != 1 {
+		t.Fatalf("\nexpected %v after %d call\nreceived %v after %d calls", 2.0, 1, rec, calls)
+	}
+
+	if m, n := G.Dimensions(); m != 1000 || n != 1000 {
+		t.Fatalf("\nexpected %dx%d\nreceived %dx%d", 1000, 1000, m, n)
+	}
+
+	exp := Matrix{vector.Vector{0, -1}, vector.Vector{1, 0}}
+	if rec := Materialize(Generate(2, 2, func(i, j int) float64 { return float64(i - j) })); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestMaterialize(t *testing.T) {
+	A := Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+	B := Materialize(A)
+	if !B.Equals(A) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, B)
+	}
+
+	if B.Set(0, 0, 5); A[0][0] != 1 {
+		t.Fatalf("\nexpected A unchanged\nreceived %v", A)
+	}
+
+	if rec := Materialize(NewDense(2, 2, []float64{1, 2, 3, 4}).T()); !rec.Equals(A.Transpose()) {
+		t.Fatalf("\nexpected %v\nreceived %v", A.Transpose(), rec)
+	}
+}
+
+func TestMultiplyVector(t *testing.T) {
+	var (
+		x   = vector.Vector{1, 1}
+		exp = vector.Vector{3, 7}
+	)
+
+	for _, A := range []Interface{
+		Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}},
+		NewDense(2, 2, []float64{1, 2, 3, 4}),
+		Generate(2, 2, func(i, j int) float64 { return float64(2*i + j + 1) }),
+	} {
+		if rec := MultiplyVector(A, x); !rec.Equal(exp) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	var (
+		A   = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		D   = NewDense(2, 2, []float64{0, 1, 1, 0})
+		I   = Generate(2, 2, func(i, j int) float64 { return float64(1 - (i+j)%2) })
+		exp = Matrix{vector.Vector{2, 1}, vector.Vector{4, 3}}
+	)
+
+	if rec := Product(A, D, I); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestRowView(t *testing.T) {
+	A := Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+	A.RowView(1)[0] = 5
+	if A[1][0] != 5 {
+		t.Fatalf("\nexpected %v\nreceived %v", 5.0, A[1][0])
+	}
+}
+
+func TestSolveInterface(t *testing.T) {
+	var (
+		D   = NewDense(2, 2, []float64{2, 1, 1, 3})
+		exp = vector.Vector{1, 2}
+	)
+
+	x, err := Solve(D, vector.Vector{4, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !x.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, x)
+	}
+
+	if _, err := Solve(Generate(2, 2, func(i, j int) float64 { return 1 }), exp); err != ErrSingular {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+	}
+
+	if rec := Pow(D, 2); !rec.Equals(Matrix{vector.Vector{5, 5}, vector.Vector{5, 10}}) {
+		t.Fatalf("\nexpected %v\nreceived %v", Matrix{vector.Vector{5, 5}, vector.Vector{5, 10}}, rec)
+	}
+}
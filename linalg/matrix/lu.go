@@ -0,0 +1,207 @@
+package matrix
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// LU is the decomposition PA = LU of a square matrix A, where P is a
+// permutation matrix, L is unit lower triangular, and U is upper triangular.
+type LU struct {
+	lu       Matrix  // L below the diagonal (its unit diagonal is implied) and U on and above it
+	pivot    []int   // Row i of PA is row pivot[i] of A
+	sign     float64 // Sign of the permutation P
+	singular bool    // Indicates U has a negligible entry on its diagonal
+}
+
+// NewLU returns the LU decomposition of a square matrix A using Gaussian
+// elimination with partial pivoting. A singular matrix is still factored, but
+// solving against it will return ErrSingular. A is considered singular if a
+// pivot is no larger than n*eps*s, where eps is the machine epsilon and s is
+// the smaller of the largest absolute entries in the pivot's row and column of
+// A. Scaling by row and column rather than by all of A keeps matrices such as
+// diag(1e20, 1) from being taken as singular.
+func NewLU(A Interface) *LU {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("matrix must be square")
+	}
+
+	F := &LU{
+		lu:    Materialize(A),
+		pivot: make([]int, 0, n),
+		sign:  1,
+	}
+
+	rowMax, colMax := vector.Zero(n), vector.Zero(n)
+	for i := 0; i < n; i++ {
+		F.pivot = append(F.pivot, i)
+		for j, a := range F.lu[i] {
+			a = gomath.Abs(a)
+			rowMax[i] = gomath.Max(rowMax[i], a)
+			colMax[j] = gomath.Max(colMax[j], a)
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		// Choose the row with the largest entry in column k as the pivot.
+		p := k
+		for i := k + 1; i < n; i++ {
+			if gomath.Abs(F.lu[p][k]) < gomath.Abs(F.lu[i][k]) {
+				p = i
+			}
+		}
+
+		if p != k {
+			F.lu.SwapRows(p, k)
+			F.pivot[p], F.pivot[k] = F.pivot[k], F.pivot[p]
+			F.sign = -F.sign
+		}
+
+		if gomath.Abs(F.lu[k][k]) <= float64(n)*epsilon*gomath.Min(rowMax[F.pivot[k]], colMax[k]) {
+			F.singular = true
+		}
+
+		if F.lu[k][k] == 0 {
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			F.lu[i][k] /= F.lu[k][k]
+			for j := k + 1; j < n; j++ {
+				F.lu[i][j] -= F.lu[i][k] * F.lu[k][j]
+			}
+		}
+	}
+
+	return F
+}
+
+// Determinant returns the determinant of the factored matrix.
+func (F *LU) Determinant() float64 {
+	det := F.sign
+	for i, r := range F.lu {
+		det *= r[i]
+	}
+
+	return det
+}
+
+// Inverse returns the inverse of the factored matrix.
+func (F *LU) Inverse() (Matrix, error) {
+	n := len(F.lu)
+	return F.SolveMatrix(Identity(n, n))
+}
+
+// IsSingular returns true if the factored matrix has no inverse.
+func (F *LU) IsSingular() bool {
+	return F.singular
+}
+
+// L returns the unit lower triangular factor.
+func (F *LU) L() Matrix {
+	n := len(F.lu)
+	f := func(i, j int) float64 {
+		switch {
+		case i == j:
+			return 1
+		case j < i:
+			return F.lu[i][j]
+		default:
+			return 0
+		}
+	}
+
+	return New(n, n, f)
+}
+
+// P returns the permutation matrix.
+func (F *LU) P() Matrix {
+	n := len(F.lu)
+	f := func(i, j int) float64 {
+		if F.pivot[i] == j {
+			return 1
+		}
+		return 0
+	}
+
+	return New(n, n, f)
+}
+
+// Pivot returns the row permutation applied to A. Row i of PA is row Pivot()[i]
+// of A.
+func (F *LU) Pivot() []int {
+	return append(make([]int, 0, len(F.pivot)), F.pivot...)
+}
+
+// Solve Ax=y for x.
+func (F *LU) Solve(y vector.Vector) (vector.Vector, error) {
+	n := len(F.lu)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	if F.singular {
+		return nil, ErrSingular
+	}
+
+	// Forward substitution solves Lz = Py, then back substitution solves
+	// Ux = z.
+	x := vector.New(n, func(i int) float64 { return y[F.pivot[i]] })
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= F.lu[i][j] * x[j]
+		}
+	}
+
+	for i := n - 1; 0 <= i; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= F.lu[i][j] * x[j]
+		}
+
+		x[i] /= F.lu[i][i]
+	}
+
+	return x, nil
+}
+
+// SolveMatrix solves AX=B for X, treating each column of B as a separate
+// right-hand side.
+func (F *LU) SolveMatrix(B Matrix) (Matrix, error) {
+	m, n := B.Dimensions()
+	if m != len(F.lu) {
+		panic("dimension mismatch")
+	}
+
+	if F.singular {
+		return nil, ErrSingular
+	}
+
+	X := Empty(m, n)
+	for j := 0; j < n; j++ {
+		x, err := F.Solve(vector.New(m, func(i int) float64 { return B[i][j] }))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < m; i++ {
+			X[i][j] = x[i]
+		}
+	}
+
+	return X, nil
+}
+
+// U returns the upper triangular factor.
+func (F *LU) U() Matrix {
+	n := len(F.lu)
+	f := func(i, j int) float64 {
+		if i <= j {
+			return F.lu[i][j]
+		}
+		return 0
+	}
+
+	return New(n, n, f)
+}
@@ -0,0 +1,101 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestLU(t *testing.T) {
+	A := Matrix{
+		vector.Vector{2, 1, 1, 0},
+		vector.Vector{4, 3, 3, 1},
+		vector.Vector{8, 7, 9, 5},
+		vector.Vector{6, 7, 9, 8},
+	}
+
+	F := NewLU(A)
+	if F.IsSingular() {
+		t.Fatalf("\nexpected non-singular factorization of %v", A)
+	}
+
+	if PA, LU := Multiply(F.P(), A), Multiply(F.L(), F.U()); !PA.Approx(LU, 1e-12) {
+		t.Fatalf("\nexpected PA = %v\nreceived LU = %v", PA, LU)
+	}
+
+	if det := A.Determinant(); det < 8-1e-12 || 8+1e-12 < det {
+		t.Fatalf("\nexpected 8\nreceived %v", det)
+	}
+
+	x := vector.Vector{1, -2, 3, -4}
+	y := Multiply(A, ColumnMatrix(x)).Vector()
+	if rec, err := F.Solve(y); err != nil || !rec.Approx(x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	if rec := Multiply(A, A.Inverse()); !rec.Approx(Identity(4, 4), 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", Identity(4, 4), rec)
+	}
+}
+
+func TestLUScaled(t *testing.T) {
+	// The entries differ greatly in size, but the matrix is easily solved.
+	A := Matrix{
+		vector.Vector{1e20, 0, 0},
+		vector.Vector{0, 1, 0},
+		vector.Vector{0, 0, 1},
+	}
+
+	if NewLU(A).IsSingular() {
+		t.Fatalf("\nexpected non-singular factorization of %v", A)
+	}
+
+	if det := A.Determinant(); det != 1e20 {
+		t.Fatalf("\nexpected %v\nreceived %v", 1e20, det)
+	}
+
+	exp := vector.Vector{1, 2, 3}
+	if rec := A.Solve(vector.Vector{1e20, 2, 3}); !rec.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec := A.Inverse(); rec[0][0] != 1e-20 || rec[2][2] != 1 {
+		t.Fatalf("\nexpected diag(1e-20, 1, 1)\nreceived %v", rec)
+	}
+}
+
+func TestLUSingular(t *testing.T) {
+	tests := []Matrix{
+		{
+			vector.Vector{1, 2, 3},
+			vector.Vector{2, 4, 6},
+			vector.Vector{1, 0, 1},
+		},
+		{
+			// Singular, but rounding leaves a pivot near 1e-16
+			vector.Vector{1, 2, 3},
+			vector.Vector{4, 5, 6},
+			vector.Vector{7, 8, 9},
+		},
+	}
+
+	for _, A := range tests {
+		F := NewLU(A)
+		if !F.IsSingular() {
+			t.Fatalf("\nexpected singular factorization of %v", A)
+		}
+
+		if det := F.Determinant(); 1e-12 < gomath.Abs(det) {
+			t.Fatalf("\nexpected 0\nreceived %v", det)
+		}
+
+		if _, err := F.Solve(vector.Vector{1, 2, 3}); err != ErrSingular {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+		}
+
+		if _, err := F.Inverse(); err != ErrSingular {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrSingular, err)
+		}
+	}
+}
@@ -27,14 +27,9 @@ type Generator func(i, j int) float64
 // ------------------------------------------------------------------------------
 
 // New generates an m-by-n matrix with entries defined by a generating function
-// f.
+// f. Use Generate to defer evaluating f until entries are read.
 func New(m, n int, f Generator) Matrix {
-	A := make(Matrix, 0, m)
-	for i := 0; i < m; i++ {
-		A = append(A, vector.New(n, func(j int) float64 { return f(i, j) }))
-	}
-
-	return A
+	return Materialize(Generate(m, n, f))
 }
 
 // Empty returns an m-by-n matrix with zeroes for all entries.
@@ -226,7 +221,6 @@ func (A Matrix) Cost(B Matrix) int {
 
 // Determinant returns the Determinant of a square matrix.
 func (A Matrix) Determinant() float64 {
-	// TODO //
 	m, n := A.Dimensions()
 	if m == 0 || n == 0 {
 		panic("cannot take determinant of empty matrix")
@@ -241,28 +235,18 @@ func (A Matrix) Determinant() float64 {
 		return A[0][0]
 	case 2:
 		return A[0][0]*A[1][1] - A[0][1]*A[1][0]
-	case 3:
-		return A[0][0]*(A[1][2]*A[2][2]-A[1][2]*A[2][1]) - A[0][1]*(A[1][0]*A[2][2]-A[1][2]*A[2][0]) + A[0][2]*(A[1][0]*A[2][1]-A[1][1]*A[2][0])
 	}
 
-	// This is probably terrible...
-	var (
-		B   = A.RemoveRow(0)
-		sgn = 1.0
-		det float64
-	)
-
-	for i := 0; 0 < m; i++ {
-		det += sgn * A[0][i] * B.RemoveColumn(i).Determinant()
-		sgn *= -1
-	}
-
-	return det
+	return NewLU(A).Determinant()
 }
 
 // Dimensions returns the Dimensions (number of rows, number of columns) of a
-// matrix.
+// matrix. A matrix without rows has no columns.
 func (A Matrix) Dimensions() (int, int) {
+	if len(A) == 0 {
+		return 0, 0
+	}
+
 	m, n := len(A), len(A[0])
 	for _, r := range A {
 		if n != len(r) {
@@ -281,85 +265,27 @@ func (A Matrix) Equals(B Matrix) bool {
 
 // Join returns a matrix that is the joining of two given matrices.
 func (A Matrix) Join(B Matrix) Matrix {
-	ma, na := A.Dimensions()
-	mb, nb := B.Dimensions()
-	if ma != mb {
-		panic("matrices must have equal number of rows")
-	}
-
-	f := func(i, j int) float64 {
-		if j < na {
-			return A[i][j]
-		}
-		return B[i][j-na]
-	}
-
-	return New(ma, na+nb, f)
+	return HStack(A, B)
 }
 
-// multiply returns AB. TODO: Correct the implementation of Winograd's algorithm.
+// multiply returns AB. Large square matrices are multiplied by Strassen's
+// algorithm and all others by the blocked, parallel kernel.
 func (A Matrix) multiply(B Matrix) Matrix {
+	if C, ok := multiplyEmpty(A, B); ok {
+		return C
+	}
+
 	ma, na := A.Dimensions()
 	mb, nb := B.Dimensions()
 	if na != mb {
 		panic("A and B are of incompatible dimensions")
 	}
 
-	f := func(i, j int) float64 {
-		var v float64
-		for k := 0; k < na; k++ {
-			v += A[i][k] * B[k][j]
-		}
-
-		return v
+	if strassenMin <= ma && ma == na && na == nb {
+		return strassen(A, B)
 	}
 
-	return New(ma, nb, f)
-
-	/*
-		// Winograd's algorithm
-		// Source: Analysis of Algorithms, 2nd Ed., by Jeffrey J.
-		// McConnell, pg 139-140
-		var (
-			d      = na >> 1
-			rfacts = make([]float64, 0, ma)
-			cfacts = make([]float64, 0, nb)
-		)
-
-		for i := 0; i < ma; i++ {
-			rfacts = append(rfacts, A[i][0]*A[i][1])
-			for j := 1; j < d; j++ {
-				rfacts[i] += A[i][j<<1] * A[i][j<<1+1]
-			}
-		}
-
-		for i := 0; i < nb; i++ {
-			cfacts = append(cfacts, B[0][i]*B[1][i])
-			for j := 1; j < d; j++ {
-				cfacts[i] += B[j<<1][i] * B[j<<1+1][i]
-			}
-		}
-
-		C := Empty(ma, nb)
-		for i := 0; i < ma; i++ {
-			for j := 0; j < nb; j++ {
-				C[i][j] = -rfacts[i] - cfacts[j]
-				for k := 0; k < d; k++ {
-					C[i][j] += (A[i][k<<1] + B[k<<1+1][j]) * (A[i][k<<1+1] + B[2*k][j])
-				}
-			}
-		}
-
-		if na%1 == 1 {
-			for i := 0; i < ma; i++ {
-				for j := 0; j < nb; j++ {
-					C[i][j] += A[i][na-1] * B[na-1][j]
-				}
-			}
-		}
-
-		return C
-	*/
+	return MultiplyParallel(A, B, Workers)
 }
 
 // Multiply several matrices.
@@ -389,7 +315,11 @@ func Multiply(As ...Matrix) Matrix {
 		order = append(order, make([]int, n))
 	}
 
-	dims = append(dims, len(As[n-1][0]))
+	if last := As[n-1]; 0 < len(last) {
+		dims = append(dims, len(last[0]))
+	} else {
+		dims = append(dims, 0)
+	}
 	for h := 1; h < n; h++ {
 		for i := 0; i < n-h; i++ {
 			j = i + h
@@ -457,7 +387,7 @@ func (A Matrix) MultiplyRow(i int, a float64) {
 
 // Pow returns A^p, for square matrix A and -1 <= p. If p = -1, the inverse is
 // returned. All other negative values for p will panic.
-func Pow(A Matrix, p int) Matrix {
+func Pow(A Interface, p int) Matrix {
 	m, n := A.Dimensions()
 	switch {
 	case m != n:
@@ -465,12 +395,17 @@ func Pow(A Matrix, p int) Matrix {
 	case p < -1:
 		panic("power must be non-negative, except for -1")
 	case p == -1:
-		return A.Inverse()
+		B, err := NewLU(A).Inverse()
+		if err != nil {
+			panic(err.Error())
+		}
+
+		return B
 	}
 
 	// Yacca's method
 	B := Identity(m, n)
-	C := A.Copy()
+	C := Materialize(A)
 	for ; 0 < p; p >>= 1 {
 		if p&1 == 1 {
 			B = Multiply(B, C)
@@ -591,75 +526,25 @@ func (A Matrix) SwapRows(i, j int) {
 	A[i], A[j] = A[j], A[i]
 }
 
-// Solve Ax=y for x.
+// Solve Ax=y for x, for square matrix A. If A is singular, Solve will panic.
 func (A Matrix) Solve(y vector.Vector) vector.Vector {
-	B := A.Join(ColumnMatrix(y)).RemoveMultiples()
-	m, n := B.Dimensions()
-	for i := 1; i < m; i++ {
-		for j := i; j < m; j++ {
-			if B[j][j-1] != 0 {
-				B[j].Divide(B[j][j-1])
-				B[j].Multiply(B[j-1][j-1])
-				B[j].Subtract(B[i-1])
-			}
-		}
+	x, err := NewLU(A).Solve(y)
+	if err != nil {
+		panic(err.Error())
 	}
 
-	for i := m - 2; 0 <= i; i-- {
-		for j := i; 0 <= j; j-- {
-			if B[j][j+1] != 0 {
-				B[j].Divide(B[j][j+1])
-				B[j].Multiply(B[j+1][j+1])
-				B[j].Subtract(B[i+1])
-			}
-		}
-	}
-
-	for i, b := range B {
-		if b[i] != 0 {
-			b.Divide(b[i])
-		}
-	}
-
-	return vector.New(m, func(i int) float64 { return B[i][n-1] }) // x = A^-1*b
+	return x
 }
 
 // Inverse of a square matrix. Caution: not all matrices, even square ones, are
-// guarenteed to be invertible.
+// guarenteed to be invertible. If A is singular, Inverse will panic.
 func (A Matrix) Inverse() Matrix {
-	m, n := A.Dimensions()
-	if m != n {
-		panic("invalid dimensions")
-	}
-
-	B := A.Join(Identity(m, m))
-	for i := 1; i < m; i++ {
-		for j := i; j < m; j++ {
-			if B[j][j-1] != 0 {
-				B[j].Divide(B[j][j-1])
-				B[j].Multiply(B[j-1][j-1])
-				B[j].Subtract(B[i-1])
-			}
-		}
+	B, err := NewLU(A).Inverse()
+	if err != nil {
+		panic(err.Error())
 	}
 
-	for i := m - 2; 0 <= i; i-- {
-		for j := i; 0 <= j; j-- {
-			if B[j][j+1] != 0 {
-				B[j].Divide(B[j][j+1])
-				B[j].Multiply(B[j+1][j+1])
-				B[j].Subtract(B[i+1])
-			}
-		}
-	}
-
-	for i, b := range B {
-		if b[i] != 0 {
-			b.Divide(b[i])
-		}
-	}
-
-	return New(m, m, func(i, j int) float64 { return B[i+m][j] })
+	return B
 }
 
 // RemoveColumn returns a copy of a matrix with column i removed.
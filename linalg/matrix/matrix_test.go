@@ -62,7 +62,7 @@ func TestSolve(t *testing.T) {
 		}
 		return 4.5
 	})
-	if !x.Equal(y) {
+	if !x.Approx(y, 1e-12) {
 		t.Fatalf("expected %v, received %v", y, x)
 	}
 
@@ -88,7 +88,7 @@ func TestSolve(t *testing.T) {
 		}
 		return 32
 	})
-	if !x.Equal(y) {
+	if !x.Approx(y, 1e-12) {
 		t.Fatalf("expected %v, received %v", y, x)
 	}
 }
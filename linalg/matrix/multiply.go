@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Workers is the number of goroutines used to multiply two matrices. If it is
+// not positive, runtime.GOMAXPROCS(0) is used.
+var Workers = 0
+
+const (
+	// blockSize is the side length of the square tiles the multiplication
+	// kernel works on. Three blockSize-by-blockSize tiles of float64 fit in a
+	// typical L2 cache.
+	blockSize = 64
+
+	// parallelMin is the number of multiply-adds below which multiplication
+	// is done on a single goroutine, as starting more costs more than it saves.
+	parallelMin = 1 << 18
+
+	// strassenMin is the dimension at and above which square matrices are
+	// multiplied by Strassen's algorithm. Below it, the blocked kernel is
+	// faster.
+	strassenMin = 512
+)
+
+// MultiplyParallel returns AB using a cache-blocked kernel, splitting the rows
+// of the product among a number of goroutines. If workers is not positive,
+// runtime.GOMAXPROCS(0) is used.
+func MultiplyParallel(A, B Matrix, workers int) Matrix {
+	if C, ok := multiplyEmpty(A, B); ok {
+		return C
+	}
+
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	C := Empty(ma, nb)
+	if ma*na*nb < parallelMin || workers == 1 {
+		multiplyBlocked(C, A, B, 0, ma)
+		return C
+	}
+
+	// Each worker takes whole row blocks of C, so no two workers write to the
+	// same row.
+	var (
+		blocks = make(chan int)
+		wg     sync.WaitGroup
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i0 := range blocks {
+				multiplyBlocked(C, A, B, i0, i0+blockSize)
+			}
+		}()
+	}
+
+	for i0 := 0; i0 < ma; i0 += blockSize {
+		blocks <- i0
+	}
+
+	close(blocks)
+	wg.Wait()
+	return C
+}
+
+// multiplyEmpty returns AB and true if A has no rows or no columns, in which
+// case AB has no entries. A matrix without rows carries no column count, so AB
+// has a row for each row of A, each of which is empty.
+func multiplyEmpty(A, B Matrix) (Matrix, bool) {
+	switch {
+	case len(A) == 0:
+		return Matrix{}, true
+	case len(B) == 0:
+		if _, n := A.Dimensions(); n != 0 {
+			panic("A and B are of incompatible dimensions")
+		}
+
+		return Empty(len(A), 0), true
+	default:
+		return nil, false
+	}
+}
+
+// multiplyBlocked adds rows [i0,i1) of AB to C, one tile at a time. Within a
+// tile, the innermost loop runs along rows of B and C so memory is read in
+// order. Each entry of C accumulates its products in the same order as a naive
+// inner product would.
+func multiplyBlocked(C, A, B Matrix, i0, i1 int) {
+	var (
+		m, n = len(A), len(A[0])
+		p    = len(B[0])
+	)
+
+	if m < i1 {
+		i1 = m
+	}
+
+	for k0 := 0; k0 < n; k0 += blockSize {
+		k1 := k0 + blockSize
+		if n < k1 {
+			k1 = n
+		}
+
+		for j0 := 0; j0 < p; j0 += blockSize {
+			j1 := j0 + blockSize
+			if p < j1 {
+				j1 = p
+			}
+
+			for i := i0; i < i1; i++ {
+				a, c := A[i], C[i][j0:j1]
+				for k := k0; k < k1; k++ {
+					aik, b := a[k], B[k][j0:j1]
+					for j := range c {
+						c[j] += aik * b[j]
+					}
+				}
+			}
+		}
+	}
+}
+
+// Strassen returns AB for n-by-n matrices A and B using Strassen's algorithm,
+// which takes O(n^2.81) operations. The result may differ from the ordinary
+// product by rounding error.
+func Strassen(A, B Matrix) Matrix {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != na || mb != nb || na != mb {
+		panic("matrices must be square and of equal dimensions")
+	}
+
+	return strassen(A, B)
+}
+
+// strassen returns AB for n-by-n matrices A and B. Sub-problems no larger than
+// 2*blockSize are handed to the blocked kernel. An odd dimension is padded with
+// a zero row and column.
+func strassen(A, B Matrix) Matrix {
+	n := len(A)
+	if n <= 2*blockSize {
+		return MultiplyParallel(A, B, Workers)
+	}
+
+	if n&1 == 1 {
+		pad := func(M Matrix) Matrix {
+			return New(n+1, n+1, func(i, j int) float64 {
+				if i < n && j < n {
+					return M[i][j]
+				}
+				return 0
+			})
+		}
+
+		C := strassen(pad(A), pad(B))
+		return New(n, n, func(i, j int) float64 { return C[i][j] })
+	}
+
+	h := n >> 1
+	quarter := func(M Matrix, r, c int) Matrix {
+		return New(h, h, func(i, j int) float64 { return M[r*h+i][c*h+j] })
+	}
+
+	var (
+		A11, A12, A21, A22 = quarter(A, 0, 0), quarter(A, 0, 1), quarter(A, 1, 0), quarter(A, 1, 1)
+		B11, B12, B21, B22 = quarter(B, 0, 0), quarter(B, 0, 1), quarter(B, 1, 0), quarter(B, 1, 1)
+
+		M1 = strassen(Add(A11, A22), Add(B11, B22))
+		M2 = strassen(Add(A21, A22), B11)
+		M3 = strassen(A11, Subtract(B12, B22))
+		M4 = strassen(A22, Subtract(B21, B11))
+		M5 = strassen(Add(A11, A12), B22)
+		M6 = strassen(Subtract(A21, A11), Add(B11, B12))
+		M7 = strassen(Subtract(A12, A22), Add(B21, B22))
+	)
+
+	f := func(i, j int) float64 {
+		r, c := i%h, j%h
+		switch {
+		case i < h && j < h:
+			return M1[r][c] + M4[r][c] - M5[r][c] + M7[r][c]
+		case i < h:
+			return M3[r][c] + M5[r][c]
+		case j < h:
+			return M2[r][c] + M4[r][c]
+		default:
+			return M1[r][c] - M2[r][c] + M3[r][c] + M6[r][c]
+		}
+	}
+
+	return New(n, n, f)
+}
@@ -0,0 +1,111 @@
+package matrix
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// naiveMultiply returns AB by the textbook triple loop.
+func naiveMultiply(A, B Matrix) Matrix {
+	_, n := A.Dimensions()
+	return New(len(A), len(B[0]), func(i, j int) float64 {
+		var v float64
+		for k := 0; k < n; k++ {
+			v += A[i][k] * B[k][j]
+		}
+
+		return v
+	})
+}
+
+// random returns an m-by-n matrix with small integer entries, so that products
+// are exact.
+func random(r *rand.Rand, m, n int) Matrix {
+	return New(m, n, func(i, j int) float64 { return float64(r.Intn(21) - 10) })
+}
+
+func TestMultiplyParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	dims := [][3]int{{1, 1, 1}, {3, 5, 2}, {64, 64, 64}, {65, 129, 63}, {200, 70, 150}}
+	for _, d := range dims {
+		A, B := random(r, d[0], d[1]), random(r, d[1], d[2])
+		exp := naiveMultiply(A, B)
+		for _, workers := range []int{0, 1, 3, 8} {
+			if rec := MultiplyParallel(A, B, workers); !exp.Equals(rec) {
+				t.Fatalf("\n%d-by-%d times %d-by-%d with %d workers differs from naive product", d[0], d[1], d[1], d[2], workers)
+			}
+		}
+	}
+}
+
+func TestMultiplyEmpty(t *testing.T) {
+	var (
+		A = Matrix{vector.Vector{1, 2}, vector.Vector{3, 4}}
+		B = Matrix{vector.Vector{}, vector.Vector{}} // 2-by-0
+	)
+
+	tests := []struct {
+		rec  Matrix
+		rows int
+	}{
+		{rec: MultiplyParallel(Matrix{}, A, 0), rows: 0},
+		{rec: MultiplyParallel(B, Matrix{}, 0), rows: 2},
+		{rec: Multiply(Matrix{}, A), rows: 0},
+		{rec: Multiply(A, B), rows: 2},
+		{rec: Multiply(A, B, Matrix{}), rows: 2},
+		{rec: Multiply(A, A, B), rows: 2},
+	}
+
+	for _, test := range tests {
+		if len(test.rec) != test.rows {
+			t.Fatalf("\nexpected %d rows\nreceived %v", test.rows, test.rec)
+		}
+
+		for _, r := range test.rec {
+			if len(r) != 0 {
+				t.Fatalf("\nexpected no entries\nreceived %v", test.rec)
+			}
+		}
+	}
+}
+
+func TestStrassen(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{5, 128, 257, 301} {
+		A, B := random(r, n, n), random(r, n, n)
+		if exp, rec := naiveMultiply(A, B), Strassen(A, B); !exp.Equals(rec) {
+			t.Fatalf("\n%d-by-%d Strassen product differs from naive product", n, n)
+		}
+	}
+}
+
+func benchmarkMultiply(b *testing.B, n int, f func(A, B Matrix) Matrix) {
+	r := rand.New(rand.NewSource(0))
+	A, B := random(r, n, n), random(r, n, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = f(A, B)
+	}
+}
+
+func BenchmarkMultiplyNaive256(b *testing.B) {
+	benchmarkMultiply(b, 256, naiveMultiply)
+}
+
+func BenchmarkMultiplySerial256(b *testing.B) {
+	benchmarkMultiply(b, 256, func(A, B Matrix) Matrix { return MultiplyParallel(A, B, 1) })
+}
+
+func BenchmarkMultiplyParallel256(b *testing.B) {
+	benchmarkMultiply(b, 256, func(A, B Matrix) Matrix { return MultiplyParallel(A, B, 0) })
+}
+
+func BenchmarkMultiplyParallel1024(b *testing.B) {
+	benchmarkMultiply(b, 1024, func(A, B Matrix) Matrix { return MultiplyParallel(A, B, 0) })
+}
+
+func BenchmarkStrassen1024(b *testing.B) {
+	benchmarkMultiply(b, 1024, Strassen)
+}
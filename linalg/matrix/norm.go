@@ -0,0 +1,116 @@
+package matrix
+
+import (
+	gomath "math"
+)
+
+// ------------------------------------------------------------------------------
+// NORMS
+// ------------------------------------------------------------------------------
+// Each norm is a method with the signature func(Matrix) float64 as a method
+// expression, such as Matrix.FrobeniusNorm, so that any of them may be passed
+// to ApproxRelative.
+// ------------------------------------------------------------------------------
+
+// ApproxRelative returns true if ||A-B|| <= tol*max(||A||,||B||) for a given
+// norm, which is the Frobenius norm if norm is nil. Unlike Approx, the
+// comparison scales with the size of the entries, so tol may be any
+// non-negative value.
+func (A Matrix) ApproxRelative(B Matrix, tol float64, norm func(Matrix) float64) bool {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		return false
+	}
+
+	if norm == nil {
+		norm = Matrix.FrobeniusNorm
+	}
+
+	return norm(Subtract(A, B)) <= tol*gomath.Max(norm(A), norm(B))
+}
+
+// FrobeniusNorm returns the square root of the sum of the squares of the
+// entries of A. It does not overflow unless the result does.
+func (A Matrix) FrobeniusNorm() float64 {
+	// The sum of squares is kept as scale^2 * ssq, where scale is the largest
+	// absolute entry seen so far (LAPACK's dlassq).
+	var scale, ssq float64 = 0, 1
+	for _, r := range A {
+		for _, a := range r {
+			if a == 0 {
+				continue
+			}
+
+			if a = gomath.Abs(a); scale < a {
+				ssq = 1 + ssq*(scale/a)*(scale/a)
+				scale = a
+			} else {
+				ssq += (a / scale) * (a / scale)
+			}
+		}
+	}
+
+	return scale * gomath.Sqrt(ssq)
+}
+
+// InfNorm returns the largest absolute row sum of A, which is the norm induced
+// by the vector infinity-norm.
+func (A Matrix) InfNorm() float64 {
+	var norm float64
+	for _, r := range A {
+		var s float64
+		for _, a := range r {
+			s += gomath.Abs(a)
+		}
+
+		norm = gomath.Max(norm, s)
+	}
+
+	return norm
+}
+
+// NuclearNorm returns the sum of the singular values of A.
+func (A Matrix) NuclearNorm() float64 {
+	var norm float64
+	for _, s := range A.singularValues() {
+		norm += s
+	}
+
+	return norm
+}
+
+// OneNorm returns the largest absolute column sum of A, which is the norm
+// induced by the vector 1-norm.
+func (A Matrix) OneNorm() float64 {
+	_, n := A.Dimensions()
+	sums := make([]float64, n)
+	for _, r := range A {
+		for j, a := range r {
+			sums[j] += gomath.Abs(a)
+		}
+	}
+
+	var norm float64
+	for _, s := range sums {
+		norm = gomath.Max(norm, s)
+	}
+
+	return norm
+}
+
+// singularValues returns the singular values of A in descending order.
+func (A Matrix) singularValues() []float64 {
+	F, err := NewSVD(A)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return F.values
+}
+
+// SpectralNorm returns the largest singular value of A, which is the norm
+// induced by the vector 2-norm.
+func (A Matrix) SpectralNorm() float64 {
+	return A.singularValues()[0]
+}
@@ -0,0 +1,68 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestNorms(t *testing.T) {
+	var (
+		A = Matrix{
+			vector.Vector{1, -2},
+			vector.Vector{-3, 4},
+		}
+
+		// The singular values of A are sqrt(15 +/- sqrt(221)).
+		s1 = gomath.Sqrt(15 + gomath.Sqrt(221))
+		s2 = gomath.Sqrt(15 - gomath.Sqrt(221))
+	)
+
+	tests := []struct {
+		norm func(Matrix) float64
+		exp  float64
+	}{
+		{norm: Matrix.FrobeniusNorm, exp: gomath.Sqrt(30)},
+		{norm: Matrix.OneNorm, exp: 6},
+		{norm: Matrix.InfNorm, exp: 7},
+		{norm: Matrix.SpectralNorm, exp: s1},
+		{norm: Matrix.NuclearNorm, exp: s1 + s2},
+	}
+
+	for _, test := range tests {
+		if rec := test.norm(A); 1e-12 < gomath.Abs(rec-test.exp) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+
+	// Squaring the entries would overflow.
+	big := Matrix{vector.Vector{3e200, 4e200}}
+	if rec := big.FrobeniusNorm(); 1e-12 < gomath.Abs(rec/5e200-1) {
+		t.Fatalf("\nexpected %v\nreceived %v", 5e200, rec)
+	}
+
+	if rec := Empty(2, 3).FrobeniusNorm(); rec != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", 0, rec)
+	}
+}
+
+func TestApproxRelative(t *testing.T) {
+	var (
+		A = Matrix{vector.Vector{1e10, 2e10}, vector.Vector{3e10, 4e10}}
+		B = Matrix{vector.Vector{1e10 + 1, 2e10}, vector.Vector{3e10, 4e10 - 1}}
+		C = Matrix{vector.Vector{1e10, 2e10}, vector.Vector{3e10, 5e10}}
+	)
+
+	if !A.ApproxRelative(B, 1e-9, nil) {
+		t.Fatalf("\nexpected %v to approximate %v", B, A)
+	}
+
+	if A.ApproxRelative(C, 1e-9, Matrix.InfNorm) {
+		t.Fatalf("\nexpected %v not to approximate %v", C, A)
+	}
+
+	if A.ApproxRelative(Matrix{vector.Vector{1e10, 2e10}}, 1, nil) {
+		t.Fatalf("\nexpected matrices of different dimensions not to approximate each other")
+	}
+}
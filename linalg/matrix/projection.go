@@ -0,0 +1,29 @@
+package matrix
+
+import "github.com/nathangreene3/math/linalg/vector"
+
+// Projection returns the n-by-n matrix P that orthogonally projects
+// n-dimensional vectors onto the span of several vectors. That is, P = QQ^T,
+// where the columns of Q are the orthonormal basis of the span given by
+// vector.GramSchmidt with the default tolerance. P is symmetric and PP = P.
+func Projection(vs []vector.Vector) Matrix {
+	if len(vs) == 0 {
+		panic("invalid dimension")
+	}
+
+	var (
+		n     = len(vs[0])
+		basis = vector.GramSchmidt(vs, -1)
+		P     = Empty(n, n)
+	)
+
+	for _, q := range basis {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				P[i][j] += q[i] * q[j]
+			}
+		}
+	}
+
+	return P
+}
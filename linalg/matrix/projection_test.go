@@ -0,0 +1,38 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestProjection(t *testing.T) {
+	var (
+		// The plane z = 0, spanned redundantly
+		vs = []vector.Vector{
+			{1, 1, 0},
+			{2, 2, 0},
+			{1, -1, 0},
+		}
+		exp = Matrix{
+			vector.Vector{1, 0, 0},
+			vector.Vector{0, 1, 0},
+			vector.Vector{0, 0, 0},
+		}
+	)
+
+	P := Projection(vs)
+	if !P.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, P)
+	}
+
+	// Onto the line spanned by (1,1)
+	P = Projection([]vector.Vector{{1, 1}})
+	if exp := (Matrix{vector.Vector{0.5, 0.5}, vector.Vector{0.5, 0.5}}); !P.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, P)
+	}
+
+	if rec := Multiply(P, P); !rec.Approx(P, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", P, rec)
+	}
+}
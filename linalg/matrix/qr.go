@@ -0,0 +1,197 @@
+package matrix
+
+import (
+	"errors"
+	gomath "math"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// epsilon is the difference between 1 and the next larger float64.
+const epsilon = 0x1p-52
+
+// ErrRankDeficient is returned when a matrix does not have full column rank.
+var ErrRankDeficient = errors.New("matrix is rank deficient")
+
+// QR is the decomposition A = QR of an m-by-n matrix A, where Q is an m-by-m
+// orthogonal matrix and R is an m-by-n upper triangular matrix.
+type QR struct {
+	qr    Matrix        // Householder vectors on and below the diagonal and R above it
+	rdiag vector.Vector // Diagonal of R
+}
+
+// NewQR returns the QR decomposition of an m-by-n matrix A computed by
+// Householder reflections.
+func NewQR(A Interface) *QR {
+	m, n := A.Dimensions()
+	F := &QR{
+		qr:    Materialize(A),
+		rdiag: vector.Zero(n),
+	}
+
+	for k := 0; k < n && k < m; k++ {
+		// The reflection maps column k below the diagonal onto -sgn*|x|e_k.
+		var nrm float64
+		for i := k; i < m; i++ {
+			nrm = gomath.Hypot(nrm, F.qr[i][k])
+		}
+
+		if nrm == 0 {
+			continue
+		}
+
+		if F.qr[k][k] < 0 {
+			nrm = -nrm
+		}
+
+		for i := k; i < m; i++ {
+			F.qr[i][k] /= nrm
+		}
+
+		F.qr[k][k]++
+		for j := k + 1; j < n; j++ {
+			var s float64
+			for i := k; i < m; i++ {
+				s += F.qr[i][k] * F.qr[i][j]
+			}
+
+			s = -s / F.qr[k][k]
+			for i := k; i < m; i++ {
+				F.qr[i][j] += s * F.qr[i][k]
+			}
+		}
+
+		F.rdiag[k] = -nrm
+	}
+
+	return F
+}
+
+// applyQT overwrites y with Q^T y.
+func (F *QR) applyQT(y vector.Vector) {
+	m, n := F.qr.Dimensions()
+	for k := 0; k < n && k < m; k++ {
+		if F.rdiag[k] == 0 {
+			continue
+		}
+
+		var s float64
+		for i := k; i < m; i++ {
+			s += F.qr[i][k] * y[i]
+		}
+
+		s = -s / F.qr[k][k]
+		for i := k; i < m; i++ {
+			y[i] += s * F.qr[i][k]
+		}
+	}
+}
+
+// IsFullRank returns true if the factored matrix has full column rank. A
+// diagonal entry of R is considered zero if it is negligible relative to the
+// largest one.
+func (F *QR) IsFullRank() bool {
+	m, n := F.qr.Dimensions()
+	if m < n {
+		return false
+	}
+
+	var max float64
+	for _, r := range F.rdiag {
+		max = gomath.Max(max, gomath.Abs(r))
+	}
+
+	tol := float64(m) * max * epsilon
+	for _, r := range F.rdiag {
+		if gomath.Abs(r) <= tol {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Q returns the m-by-m orthogonal factor.
+func (F *QR) Q() Matrix {
+	m, n := F.qr.Dimensions()
+	Q := Identity(m, m)
+
+	// Applying the reflections in reverse order to the identity builds
+	// Q = H_0 H_1 ... H_{n-1}.
+	for k := math.MinInt(m, n) - 1; 0 <= k; k-- {
+		if F.rdiag[k] == 0 {
+			continue
+		}
+
+		for j := 0; j < m; j++ {
+			var s float64
+			for i := k; i < m; i++ {
+				s += F.qr[i][k] * Q[i][j]
+			}
+
+			s = -s / F.qr[k][k]
+			for i := k; i < m; i++ {
+				Q[i][j] += s * F.qr[i][k]
+			}
+		}
+	}
+
+	return Q
+}
+
+// R returns the m-by-n upper triangular factor.
+func (F *QR) R() Matrix {
+	m, n := F.qr.Dimensions()
+	f := func(i, j int) float64 {
+		switch {
+		case i == j:
+			return F.rdiag[i]
+		case i < j:
+			return F.qr[i][j]
+		default:
+			return 0
+		}
+	}
+
+	return New(m, n, f)
+}
+
+// LeastSquares returns the vector x minimizing |Ax-y| and the residual norm
+// |Ax-y|, for an m-by-n matrix A with m >= n. If A does not have full column
+// rank, the minimizer is not unique and ErrRankDeficient is returned.
+func LeastSquares(A Interface, y vector.Vector) (vector.Vector, float64, error) {
+	m, n := A.Dimensions()
+	switch {
+	case m < n:
+		panic("matrix must have at least as many rows as columns")
+	case m != len(y):
+		panic("dimension mismatch")
+	}
+
+	F := NewQR(A)
+	if !F.IsFullRank() {
+		return nil, 0, ErrRankDeficient
+	}
+
+	// Solve Rx = (Q^T y)[:n] by back substitution. The remaining entries of
+	// Q^T y are the part of y orthogonal to the column space of A.
+	z := y.Copy()
+	F.applyQT(z)
+
+	x := vector.New(n, func(i int) float64 { return z[i] })
+	for i := n - 1; 0 <= i; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= F.qr[i][j] * x[j]
+		}
+
+		x[i] /= F.rdiag[i]
+	}
+
+	var res float64
+	for i := n; i < m; i++ {
+		res = gomath.Hypot(res, z[i])
+	}
+
+	return x, res, nil
+}
@@ -0,0 +1,74 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestQR(t *testing.T) {
+	A := Matrix{
+		vector.Vector{12, -51, 4},
+		vector.Vector{6, 167, -68},
+		vector.Vector{-4, 24, -41},
+		vector.Vector{1, 1, 1},
+	}
+
+	F := NewQR(A)
+	Q, R := F.Q(), F.R()
+	if QR := Multiply(Q, R); !QR.Approx(A, 1e-10) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, QR)
+	}
+
+	if QTQ := Multiply(Q.Transpose(), Q); !QTQ.Approx(Identity(4, 4), 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", Identity(4, 4), QTQ)
+	}
+
+	for i := range R {
+		for j := 0; j < i && j < 3; j++ {
+			if R[i][j] != 0 {
+				t.Fatalf("\nexpected upper triangular R\nreceived %v", R)
+			}
+		}
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Fit y = a + bx to the points (0,1), (1,3), (2,4), (3,4). The normal
+	// equations give a = 1.5 and b = 1 with residual 1.
+	var (
+		A = Matrix{
+			vector.Vector{1, 0},
+			vector.Vector{1, 1},
+			vector.Vector{1, 2},
+			vector.Vector{1, 3},
+		}
+		y      = vector.Vector{1, 3, 4, 4}
+		exp    = vector.Vector{1.5, 1}
+		expRes = 1.0
+	)
+
+	x, res, err := LeastSquares(A, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !x.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, x)
+	}
+
+	if 1e-12 < gomath.Abs(res-expRes) {
+		t.Fatalf("\nexpected %v\nreceived %v", expRes, res)
+	}
+
+	A = Matrix{
+		vector.Vector{1, 2},
+		vector.Vector{2, 4},
+		vector.Vector{3, 6},
+	}
+
+	if _, _, err := LeastSquares(A, vector.Vector{1, 2, 3}); err != ErrRankDeficient {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrRankDeficient, err)
+	}
+}
@@ -0,0 +1,145 @@
+package matrix
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ------------------------------------------------------------------------------
+// ROW REDUCTION AND FUNDAMENTAL SUBSPACES
+// ------------------------------------------------------------------------------
+// Each function here takes a tolerance tol. Entries with absolute value at most
+// tol are treated as zero during elimination. If tol is negative, a default of
+// max(m,n)*eps*|A| is used, where eps is the machine epsilon and |A| is the
+// largest absolute row sum of A.
+// ------------------------------------------------------------------------------
+
+// ColumnSpace returns a basis of the column space (range) of A, which consists
+// of the pivot columns of A.
+func (A Matrix) ColumnSpace(tol float64) []vector.Vector {
+	_, pivots := A.RREF(tol)
+	basis := make([]vector.Vector, 0, len(pivots))
+	for _, j := range pivots {
+		basis = append(basis, vector.New(len(A), func(i int) float64 { return A[i][j] }))
+	}
+
+	return basis
+}
+
+// LeftNullSpace returns a basis of the left null space of A, which is the set
+// of all x such that x^T A = 0.
+func (A Matrix) LeftNullSpace(tol float64) []vector.Vector {
+	return A.Transpose().NullSpace(tol)
+}
+
+// NullSpace returns a basis of the null space (kernel) of A, which is the set
+// of all x such that Ax = 0. There is one basis vector for each non-pivot
+// column of A.
+func (A Matrix) NullSpace(tol float64) []vector.Vector {
+	var (
+		R, pivots = A.RREF(tol)
+		_, n      = A.Dimensions()
+		isPivot   = make([]bool, n)
+		basis     = make([]vector.Vector, 0, n-len(pivots))
+	)
+
+	for _, j := range pivots {
+		isPivot[j] = true
+	}
+
+	// Setting free variable f to one and the others to zero determines each
+	// pivot variable from its row of R.
+	for f := 0; f < n; f++ {
+		if isPivot[f] {
+			continue
+		}
+
+		x := vector.Zero(n)
+		x[f] = 1
+		for i, j := range pivots {
+			x[j] = -R[i][f]
+		}
+
+		basis = append(basis, x)
+	}
+
+	return basis
+}
+
+// Rank returns the number of linearly independent rows (or columns) of A.
+func (A Matrix) Rank(tol float64) int {
+	_, pivots := A.RREF(tol)
+	return len(pivots)
+}
+
+// RowSpace returns a basis of the row space of A, which consists of the
+// non-zero rows of the reduced row echelon form of A.
+func (A Matrix) RowSpace(tol float64) []vector.Vector {
+	R, pivots := A.RREF(tol)
+	basis := make([]vector.Vector, 0, len(pivots))
+	for i := range pivots {
+		basis = append(basis, R[i])
+	}
+
+	return basis
+}
+
+// RREF returns the reduced row echelon form of A and the indices of its pivot
+// columns in ascending order. Gauss-Jordan elimination with partial pivoting
+// is used.
+func (A Matrix) RREF(tol float64) (Matrix, []int) {
+	var (
+		R      = A.Copy()
+		m, n   = R.Dimensions()
+		pivots = make([]int, 0, math.MinInt(m, n))
+	)
+
+	if tol < 0 {
+		var norm float64
+		for _, r := range A {
+			var s float64
+			for _, a := range r {
+				s += gomath.Abs(a)
+			}
+
+			norm = gomath.Max(norm, s)
+		}
+
+		tol = float64(math.MaxInt(m, n)) * epsilon * norm
+	}
+
+	for i, j := 0, 0; i < m && j < n; j++ {
+		p := i
+		for k := i + 1; k < m; k++ {
+			if gomath.Abs(R[p][j]) < gomath.Abs(R[k][j]) {
+				p = k
+			}
+		}
+
+		if gomath.Abs(R[p][j]) <= tol {
+			// Column j has no pivot, so what remains of it is zero.
+			for k := i; k < m; k++ {
+				R[k][j] = 0
+			}
+
+			continue
+		}
+
+		R.SwapRows(i, p)
+		R[i].Divide(R[i][j])
+		R[i][j] = 1
+		for k := 0; k < m; k++ {
+			if k != i && R[k][j] != 0 {
+				R[k].Subtract(vector.Multiply(R[k][j], R[i]))
+				R[k][j] = 0
+			}
+		}
+
+		pivots = append(pivots, j)
+		i++
+	}
+
+	return R, pivots
+}
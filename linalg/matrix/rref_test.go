@@ -0,0 +1,68 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestRREF(t *testing.T) {
+	var (
+		A = Matrix{
+			vector.Vector{1, 2, 1, 1},
+			vector.Vector{2, 4, 0, 6},
+			vector.Vector{3, 6, 1, 7},
+		}
+		expR = Matrix{
+			vector.Vector{1, 2, 0, 3},
+			vector.Vector{0, 0, 1, -2},
+			vector.Vector{0, 0, 0, 0},
+		}
+		expPivots = []int{0, 2}
+	)
+
+	R, pivots := A.RREF(-1)
+	if !R.Approx(expR, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", expR, R)
+	}
+
+	if len(pivots) != len(expPivots) || pivots[0] != expPivots[0] || pivots[1] != expPivots[1] {
+		t.Fatalf("\nexpected %v\nreceived %v", expPivots, pivots)
+	}
+
+	if r := A.Rank(-1); r != 2 {
+		t.Fatalf("\nexpected 2\nreceived %d", r)
+	}
+
+	// rank + nullity = number of columns, for both A and A^T.
+	if c, r, n, l := A.ColumnSpace(-1), A.RowSpace(-1), A.NullSpace(-1), A.LeftNullSpace(-1); len(c) != 2 || len(r) != 2 || len(n) != 2 || len(l) != 1 {
+		t.Fatalf("\nexpected dimensions 2, 2, 2, 1\nreceived %d, %d, %d, %d", len(c), len(r), len(n), len(l))
+	}
+
+	for _, x := range A.NullSpace(-1) {
+		if Ax := Multiply(A, ColumnMatrix(x)).Vector(); !Ax.Approx(vector.Zero(3), 1e-12) {
+			t.Fatalf("\nexpected %v in null space\nreceived Ax = %v", x, Ax)
+		}
+	}
+
+	for _, y := range A.LeftNullSpace(-1) {
+		if yA := Multiply(RowMatrix(y), A).Vector(); !yA.Approx(vector.Zero(4), 1e-12) {
+			t.Fatalf("\nexpected %v in left null space\nreceived yA = %v", y, yA)
+		}
+	}
+
+	// Rows that are combinations, but not multiples, of other rows.
+	B := Matrix{
+		vector.Vector{1, 0, 0},
+		vector.Vector{0, 1, 0},
+		vector.Vector{1, 1, 1e-9},
+	}
+
+	if r := B.Rank(-1); r != 3 {
+		t.Fatalf("\nexpected 3\nreceived %d", r)
+	}
+
+	if r := B.Rank(1e-6); r != 2 {
+		t.Fatalf("\nexpected 2\nreceived %d", r)
+	}
+}
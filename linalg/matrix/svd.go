@@ -0,0 +1,251 @@
+package matrix
+
+import (
+	gomath "math"
+	"sort"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// SVD is the singular value decomposition A = USV^T of an m-by-n matrix A.
+// For k = min(m,n), U is an m-by-k matrix with orthonormal columns, S is a k-by-k
+// diagonal matrix of non-negative singular values in descending order, and V is
+// an n-by-n orthogonal matrix, of which the first k columns are used.
+type SVD struct {
+	m, n   int
+	u      Matrix
+	values vector.Vector
+	v      Matrix
+}
+
+// NewSVD returns the singular value decomposition of an m-by-n matrix A
+// computed by the one-sided Jacobi method. If A has no rows or no columns,
+// ErrEmpty is returned.
+func NewSVD(A Interface) (*SVD, error) {
+	m, n := A.Dimensions()
+	switch {
+	case m == 0 || n == 0:
+		return nil, ErrEmpty
+	case m < n:
+		// A^T = USV^T, so A = VSU^T.
+		F, err := NewSVD(Materialize(A).Transpose())
+		if err != nil {
+			return nil, err
+		}
+
+		keep := make([]bool, m)
+		for i := range keep {
+			keep[i] = true
+		}
+
+		return &SVD{
+			m:      m,
+			n:      n,
+			u:      F.v,
+			values: F.values,
+			v:      completeBasis(F.u, n, keep),
+		}, nil
+	}
+
+	// Rotate pairs of columns of W = AV until they are mutually orthogonal.
+	// Then the column norms of W are the singular values and the normalized
+	// columns are the left singular vectors.
+	W, V := Materialize(A), Identity(n, n)
+	for sweep := 0; ; sweep++ {
+		if sweep == maxEigenIters {
+			return nil, ErrNoConvergence
+		}
+
+		rotated := false
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma float64
+				for i := 0; i < m; i++ {
+					alpha += W[i][p] * W[i][p]
+					beta += W[i][q] * W[i][q]
+					gamma += W[i][p] * W[i][q]
+				}
+
+				if gomath.Abs(gamma) <= epsilon*gomath.Sqrt(alpha*beta) {
+					continue
+				}
+
+				rotated = true
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (gomath.Abs(zeta) + gomath.Hypot(zeta, 1))
+				if zeta < 0 {
+					t = -t
+				}
+
+				c := 1 / gomath.Hypot(t, 1)
+				s := t * c
+				for i := 0; i < m; i++ {
+					wp, wq := W[i][p], W[i][q]
+					W[i][p], W[i][q] = c*wp-s*wq, s*wp+c*wq
+				}
+
+				for i := 0; i < n; i++ {
+					vp, vq := V[i][p], V[i][q]
+					V[i][p], V[i][q] = c*vp-s*vq, s*vp+c*vq
+				}
+			}
+		}
+
+		if !rotated {
+			break
+		}
+	}
+
+	values := vector.Zero(n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			values[j] = gomath.Hypot(values[j], W[i][j])
+		}
+	}
+
+	order := make([]int, 0, n)
+	for j := 0; j < n; j++ {
+		order = append(order, j)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return values[order[j]] < values[order[i]] })
+
+	var (
+		max  = values[order[0]]
+		keep = make([]bool, n)
+		U    = New(m, n, func(i, j int) float64 { return W[i][order[j]] })
+	)
+
+	for j := 0; j < n; j++ {
+		// Columns of negligible length have no reliable direction, so they
+		// are replaced when completing the basis.
+		if s := values[order[j]]; epsilon*max < s {
+			U.MultiplyColumn(j, 1/s)
+			keep[j] = true
+		}
+	}
+
+	return &SVD{
+		m:      m,
+		n:      n,
+		u:      completeBasis(U, n, keep),
+		values: vector.New(n, func(j int) float64 { return values[order[j]] }),
+		v:      New(n, n, func(i, j int) float64 { return V[i][order[j]] }),
+	}, nil
+}
+
+// completeBasis returns an m-by-k matrix of orthonormal columns. Column j of Q is
+// kept if keep[j] is true and those columns are assumed orthonormal. All other
+// columns are chosen by orthogonalizing standard basis vectors against the
+// columns chosen so far.
+func completeBasis(Q Matrix, k int, keep []bool) Matrix {
+	var (
+		m      = len(Q)
+		cols   = make([]vector.Vector, k)
+		chosen = make([]vector.Vector, 0, k)
+	)
+
+	for j := 0; j < len(keep); j++ {
+		if keep[j] {
+			cols[j] = vector.New(m, func(i int) float64 { return Q[i][j] })
+			chosen = append(chosen, cols[j])
+		}
+	}
+
+	e := 0 // Next standard basis vector to try
+	for j := 0; j < k; j++ {
+		if cols[j] != nil {
+			continue
+		}
+
+		for ; e < m; e++ {
+			v := vector.Zero(m)
+			v[e] = 1
+			v.Reject(chosen)
+			if r := v.Length(); 0.5 < r {
+				v.Divide(r)
+				cols[j] = v
+				chosen = append(chosen, v)
+				e++
+				break
+			}
+		}
+	}
+
+	return New(m, k, func(i, j int) float64 { return cols[j][i] })
+}
+
+// ConditionNumber returns the ratio of the largest to the smallest singular
+// value, which is +Inf if the matrix is rank deficient.
+func (F *SVD) ConditionNumber() float64 {
+	k := len(F.values)
+	if F.values[k-1] == 0 {
+		return gomath.Inf(1)
+	}
+
+	return F.values[0] / F.values[k-1]
+}
+
+// defaultTol returns the tolerance below which singular values are treated as
+// zero.
+func (F *SVD) defaultTol() float64 {
+	return float64(math.MaxInt(F.m, F.n)) * epsilon * F.values[0]
+}
+
+// NullSpace returns a matrix whose columns form an orthonormal basis of the
+// null space of A. If A has full column rank, the returned matrix has zero
+// columns.
+func (F *SVD) NullSpace() Matrix {
+	r := F.Rank(-1)
+	return New(F.n, F.n-r, func(i, j int) float64 { return F.v[i][r+j] })
+}
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse of A, which is the
+// inverse of A if A is invertible.
+func (F *SVD) PseudoInverse() Matrix {
+	r := F.Rank(-1)
+	f := func(i, j int) float64 {
+		var s float64
+		for k := 0; k < r; k++ {
+			s += F.v[i][k] * F.u[j][k] / F.values[k]
+		}
+
+		return s
+	}
+
+	return New(F.n, F.m, f)
+}
+
+// Rank returns the number of singular values greater than tol. If tol is
+// negative, a default tolerance of max(m,n)*eps*s is used, where eps is the
+// machine epsilon and s is the largest singular value.
+func (F *SVD) Rank(tol float64) int {
+	if tol < 0 {
+		tol = F.defaultTol()
+	}
+
+	var r int
+	for _, s := range F.values {
+		if tol < s {
+			r++
+		}
+	}
+
+	return r
+}
+
+// U returns the m-by-k matrix of left singular vectors.
+func (F *SVD) U() Matrix {
+	return F.u.Copy()
+}
+
+// V returns the n-by-n matrix of right singular vectors.
+func (F *SVD) V() Matrix {
+	return F.v.Copy()
+}
+
+// Values returns the singular values in descending order.
+func (F *SVD) Values() vector.Vector {
+	return F.values.Copy()
+}
@@ -0,0 +1,126 @@
+package matrix
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		A      Matrix
+		values vector.Vector
+		rank   int
+	}{
+		{
+			A: Matrix{
+				vector.Vector{3, 0},
+				vector.Vector{4, 5},
+			},
+			values: vector.Vector{3 * gomath.Sqrt(5), gomath.Sqrt(5)},
+			rank:   2,
+		},
+		{
+			A: Matrix{
+				vector.Vector{1, 2, 3},
+				vector.Vector{2, 4, 6},
+			},
+			values: vector.Vector{gomath.Sqrt(70), 0},
+			rank:   1,
+		},
+		{
+			A: Matrix{
+				vector.Vector{1, 0},
+				vector.Vector{0, 1},
+				vector.Vector{1, 1},
+			},
+			values: vector.Vector{gomath.Sqrt(3), 1},
+			rank:   2,
+		},
+	}
+
+	for _, test := range tests {
+		m, n := test.A.Dimensions()
+		F, err := NewSVD(test.A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := F.Values()
+		if !values.Approx(test.values, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.values, values)
+		}
+
+		if r := F.Rank(-1); r != test.rank {
+			t.Fatalf("\nexpected rank %d\nreceived %d", test.rank, r)
+		}
+
+		U, V := F.U(), F.V()
+		S := New(len(values), n, func(i, j int) float64 {
+			if i == j {
+				return values[i]
+			}
+			return 0
+		})
+
+		if USVT := Multiply(U, S, V.Transpose()); !USVT.Approx(test.A, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.A, USVT)
+		}
+
+		if UTU := Multiply(U.Transpose(), U); !UTU.Approx(Identity(len(values), len(values)), 1e-12) {
+			t.Fatalf("\nexpected orthonormal columns\nreceived %v", U)
+		}
+
+		if VTV := Multiply(V.Transpose(), V); !VTV.Approx(Identity(n, n), 1e-12) {
+			t.Fatalf("\nexpected orthogonal matrix\nreceived %v", V)
+		}
+
+		// The pseudo-inverse satisfies AA^+A = A.
+		if AAA := Multiply(test.A, F.PseudoInverse(), test.A); !AAA.Approx(test.A, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.A, AAA)
+		}
+
+		if test.rank < n {
+			if AN := Multiply(test.A, F.NullSpace()); !AN.Approx(Empty(m, n-test.rank), 1e-12) {
+				t.Fatalf("\nexpected zero\nreceived %v", AN)
+			}
+		}
+	}
+}
+
+func TestConditionNumber(t *testing.T) {
+	A := Matrix{
+		vector.Vector{1, 0},
+		vector.Vector{0, 1e-6},
+	}
+
+	F, err := NewSVD(A)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c := F.ConditionNumber(); gomath.Abs(c-1e6) > 1e-6 {
+		t.Fatalf("\nexpected %v\nreceived %v", 1e6, c)
+	}
+
+	if F, err = NewSVD(Matrix{vector.Vector{1, 1}, vector.Vector{1, 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if c := F.ConditionNumber(); !gomath.IsInf(c, 1) {
+		t.Fatalf("\nexpected +Inf\nreceived %v", c)
+	}
+}
+
+func TestSVDEmpty(t *testing.T) {
+	for _, A := range []Interface{
+		Matrix{},
+		Matrix{vector.Vector{}, vector.Vector{}},
+		Generate(0, 3, func(i, j int) float64 { return 1 }),
+	} {
+		if _, err := NewSVD(A); err != ErrEmpty {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrEmpty, err)
+		}
+	}
+}
@@ -0,0 +1,494 @@
+package rational
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// Elimination here is fraction-free, as described in "Fraction-free algorithms
+// for linear and polynomial equations" by George C. Nakos, Peter R. Turner, and
+// Robert M. Williams. Rows are first scaled to have integer entries, then
+// eliminated over the integers with exact division by the previous pivot, so
+// intermediate entries stay small and no fractions are reduced until the end.
+// ------------------------------------------------------------------------------
+
+// Matrix is a set of rational vectors.
+type Matrix []Vector
+
+// Vector is an ordered n-tuple of rationals.
+type Vector []*big.Rat
+
+// Generator is a function defining the (i,j)th entry of a matrix.
+type Generator func(i, j int) *big.Rat
+
+// ------------------------------------------------------------------------------
+// MATRIX CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// New generates an m-by-n matrix with entries defined by a generating function
+// f. The returned entries are copies of those f returns.
+func New(m, n int, f Generator) Matrix {
+	A := make(Matrix, 0, m)
+	for i := 0; i < m; i++ {
+		r := make(Vector, 0, n)
+		for j := 0; j < n; j++ {
+			r = append(r, new(big.Rat).Set(f(i, j)))
+		}
+
+		A = append(A, r)
+	}
+
+	return A
+}
+
+// Empty returns an m-by-n matrix with zeroes for all entries.
+func Empty(m, n int) Matrix {
+	zero := new(big.Rat)
+	return New(m, n, func(i, j int) *big.Rat { return zero })
+}
+
+// FromFloat returns a float matrix converted exactly to rationals. It panics if
+// an entry is not finite.
+func FromFloat(A matrix.Matrix) Matrix {
+	m, n := A.Dimensions()
+	return New(m, n, func(i, j int) *big.Rat {
+		r := new(big.Rat)
+		if r.SetFloat64(A[i][j]) == nil {
+			panic("entry must be finite")
+		}
+
+		return r
+	})
+}
+
+// Identity returns the m-by-n identity matrix.
+func Identity(m, n int) Matrix {
+	zero, one := new(big.Rat), big.NewRat(1, 1)
+	return New(m, n, func(i, j int) *big.Rat {
+		if i == j {
+			return one
+		}
+		return zero
+	})
+}
+
+// VectorFromFloat returns a float vector converted exactly to rationals. It
+// panics if an entry is not finite.
+func VectorFromFloat(v vector.Vector) Vector {
+	w := make(Vector, 0, len(v))
+	for _, a := range v {
+		r := new(big.Rat)
+		if r.SetFloat64(a) == nil {
+			panic("entry must be finite")
+		}
+
+		w = append(w, r)
+	}
+
+	return w
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON MATRICES
+// ------------------------------------------------------------------------------
+// In general, A.F(B) updates A and F(A,B) returns a new matrix.
+// ------------------------------------------------------------------------------
+
+// Add returns the sum of two matrices.
+func Add(A, B Matrix) Matrix {
+	C := A.Copy()
+	C.Add(B)
+	return C
+}
+
+// Add B to A.
+func (A Matrix) Add(B Matrix) {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		panic("matrices must have the same number of rows and columns")
+	}
+
+	for i := 0; i < ma; i++ {
+		for j := 0; j < na; j++ {
+			A[i][j].Add(A[i][j], B[i][j])
+		}
+	}
+}
+
+// Copy returns a deep copied matrix.
+func (A Matrix) Copy() Matrix {
+	m, n := A.Dimensions()
+	return New(m, n, func(i, j int) *big.Rat { return A[i][j] })
+}
+
+// Determinant returns the determinant of a square matrix.
+func (A Matrix) Determinant() *big.Rat {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("cannot take determinant of a non-square matrix")
+	}
+
+	// Scaling row i by s[i] scales the determinant by s[i].
+	M, s := integerRows(A, nil)
+	sign, pivots := eliminate(M, n)
+	if len(pivots) < n {
+		return new(big.Rat)
+	}
+
+	det := new(big.Rat).SetInt(M[n-1][n-1])
+	if sign < 0 {
+		det.Neg(det)
+	}
+
+	for _, si := range s {
+		det.Quo(det, new(big.Rat).SetInt(si))
+	}
+
+	return det
+}
+
+// Dimensions returns the dimensions (number of rows, number of columns) of a
+// matrix.
+func (A Matrix) Dimensions() (int, int) {
+	m, n := len(A), len(A[0])
+	for _, r := range A {
+		if n != len(r) {
+			panic("inconsistent matrix dimensions")
+		}
+	}
+
+	return m, n
+}
+
+// Equals returns true if two matrices are equal in dimension and for each
+// entry. Otherwise, it returns false.
+func (A Matrix) Equals(B Matrix) bool {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		return false
+	}
+
+	for i := 0; i < ma; i++ {
+		for j := 0; j < na; j++ {
+			if A[i][j].Cmp(B[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Float returns A converted to float64 entries, rounded to nearest, and
+// whether every entry was converted exactly.
+func (A Matrix) Float() (matrix.Matrix, bool) {
+	m, n := A.Dimensions()
+	exact := true
+	B := matrix.New(m, n, func(i, j int) float64 {
+		f, ok := A[i][j].Float64()
+		exact = exact && ok
+		return f
+	})
+
+	return B, exact
+}
+
+// Inverse of a square matrix. If A is singular, Inverse will panic.
+func (A Matrix) Inverse() Matrix {
+	m, n := A.Dimensions()
+	if m != n {
+		panic("invalid dimensions")
+	}
+
+	// If SA has integer entries for diagonal S, then reducing [SA|S] gives
+	// [I|(SA)^-1 S] = [I|A^-1].
+	M, _ := integerRows(A, Identity(n, n))
+	_, pivots := eliminate(M, n)
+	if len(pivots) < n {
+		panic("matrix is singular")
+	}
+
+	return New(n, n, func(i, j int) *big.Rat { return new(big.Rat).SetFrac(M[i][n+j], M[i][i]) })
+}
+
+// multiply returns AB.
+func (A Matrix) multiply(B Matrix) Matrix {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if na != mb {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	p := new(big.Rat)
+	return New(ma, nb, func(i, j int) *big.Rat {
+		v := new(big.Rat)
+		for k := 0; k < na; k++ {
+			v.Add(v, p.Mul(A[i][k], B[k][j]))
+		}
+
+		return v
+	})
+}
+
+// Multiply several matrices.
+func Multiply(As ...Matrix) Matrix {
+	switch len(As) {
+	case 0:
+		return nil
+	case 1:
+		return As[0]
+	}
+
+	B := As[0].multiply(As[1])
+	for _, A := range As[2:] {
+		B = B.multiply(A)
+	}
+
+	return B
+}
+
+// Pow returns A^p, for square matrix A and -1 <= p. If p = -1, the inverse is
+// returned. All other negative values for p will panic.
+func Pow(A Matrix, p int) Matrix {
+	m, n := A.Dimensions()
+	switch {
+	case m != n:
+		panic("matrix must be square")
+	case p < -1:
+		panic("power must be non-negative, except for -1")
+	case p == -1:
+		return A.Inverse()
+	}
+
+	B := Identity(m, n)
+	C := A.Copy()
+	for ; 0 < p; p >>= 1 {
+		if p&1 == 1 {
+			B = B.multiply(C)
+		}
+
+		C = C.multiply(C)
+	}
+
+	return B
+}
+
+// RREF returns the reduced row echelon form of A and the indices of its pivot
+// columns in ascending order.
+func (A Matrix) RREF() (Matrix, []int) {
+	m, n := A.Dimensions()
+	M, _ := integerRows(A, nil)
+	_, pivots := eliminate(M, n)
+
+	// Each pivot row is a multiple of the corresponding row of the reduced
+	// form, with its pivot entry as the factor.
+	R := Empty(m, n)
+	for i, p := range pivots {
+		for j := 0; j < n; j++ {
+			R[i][j].SetFrac(M[i][j], M[i][p])
+		}
+	}
+
+	return R, pivots
+}
+
+// Solve Ax=y for x, for square matrix A. If A is singular, Solve will panic.
+func (A Matrix) Solve(y Vector) Vector {
+	m, n := A.Dimensions()
+	switch {
+	case m != n:
+		panic("matrix must be square")
+	case m != len(y):
+		panic("dimension mismatch")
+	}
+
+	M, _ := integerRows(A, New(n, 1, func(i, j int) *big.Rat { return y[i] }))
+	_, pivots := eliminate(M, n)
+	if len(pivots) < n {
+		panic("matrix is singular")
+	}
+
+	x := make(Vector, 0, n)
+	for i := 0; i < n; i++ {
+		x = append(x, new(big.Rat).SetFrac(M[i][n], M[i][i]))
+	}
+
+	return x
+}
+
+// String returns a formatted string representation of a matrix.
+func (A Matrix) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, r := range A {
+		if 0 < i {
+			sb.WriteByte(',')
+		}
+
+		sb.WriteString(r.String())
+	}
+
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// Subtract returns A-B.
+func Subtract(A, B Matrix) Matrix {
+	C := A.Copy()
+	C.Subtract(B)
+	return C
+}
+
+// Subtract B from A.
+func (A Matrix) Subtract(B Matrix) {
+	ma, na := A.Dimensions()
+	mb, nb := B.Dimensions()
+	if ma != mb || na != nb {
+		panic("matrices must have the same number of rows and columns")
+	}
+
+	for i := 0; i < ma; i++ {
+		for j := 0; j < na; j++ {
+			A[i][j].Sub(A[i][j], B[i][j])
+		}
+	}
+}
+
+// Transpose a matrix.
+func (A Matrix) Transpose() Matrix {
+	m, n := A.Dimensions()
+	return New(n, m, func(i, j int) *big.Rat { return A[j][i] })
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON VECTORS
+// ------------------------------------------------------------------------------
+
+// Float returns v converted to float64 entries, rounded to nearest, and
+// whether every entry was converted exactly.
+func (v Vector) Float() (vector.Vector, bool) {
+	exact := true
+	w := vector.New(len(v), func(i int) float64 {
+		f, ok := v[i].Float64()
+		exact = exact && ok
+		return f
+	})
+
+	return w, exact
+}
+
+// String returns the default string-representation of a vector.
+func (v Vector) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, a := range v {
+		if 0 < i {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(a.RatString())
+	}
+
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// ------------------------------------------------------------------------------
+// FRACTION-FREE ELIMINATION
+// ------------------------------------------------------------------------------
+
+// integerRows returns [A|B] with each row multiplied by the least common
+// multiple of its denominators, so that every entry is an integer, along with
+// the multipliers. B may be nil.
+func integerRows(A, B Matrix) ([][]*big.Int, []*big.Int) {
+	var (
+		M = make([][]*big.Int, 0, len(A))
+		s = make([]*big.Int, 0, len(A))
+	)
+
+	for i, r := range A {
+		row := r
+		if B != nil {
+			row = append(append(make(Vector, 0, len(r)+len(B[i])), r...), B[i]...)
+		}
+
+		lcm := big.NewInt(1)
+		for _, a := range row {
+			d := a.Denom()
+			g := new(big.Int).GCD(nil, nil, lcm, d)
+			lcm.Mul(lcm, new(big.Int).Quo(d, g))
+		}
+
+		ints := make([]*big.Int, 0, len(row))
+		for _, a := range row {
+			x := new(big.Int).Mul(a.Num(), lcm)
+			ints = append(ints, x.Quo(x, a.Denom()))
+		}
+
+		M = append(M, ints)
+		s = append(s, lcm)
+	}
+
+	return M, s
+}
+
+// eliminate performs fraction-free Gauss-Jordan elimination on the integer
+// matrix M, choosing pivots among its first n columns. Each step replaces row i
+// by (p*row_i - a*row_k)/d, where p is the new pivot, a is the entry of row i in
+// the pivot column, and d is the previous pivot; the division is exact. When
+// done, every pivot row has the last pivot in its pivot column and zeroes in
+// the other pivot columns, and for a square matrix of full rank the last pivot
+// is the determinant times the sign of the row permutation, which is returned
+// along with the pivot columns.
+func eliminate(M [][]*big.Int, n int) (int, []int) {
+	var (
+		m      = len(M)
+		d      = big.NewInt(1)
+		sign   = 1
+		pivots = make([]int, 0, n)
+		t      = new(big.Int)
+	)
+
+	for i, j := 0, 0; i < m && j < n; j++ {
+		p := i
+		for ; p < m && M[p][j].Sign() == 0; p++ {
+		}
+
+		if p == m {
+			continue
+		}
+
+		if p != i {
+			M[i], M[p] = M[p], M[i]
+			sign = -sign
+		}
+
+		pivot := new(big.Int).Set(M[i][j])
+		for k := 0; k < m; k++ {
+			if k == i {
+				continue
+			}
+
+			a := new(big.Int).Set(M[k][j])
+			for c := range M[k] {
+				M[k][c].Mul(M[k][c], pivot)
+				M[k][c].Sub(M[k][c], t.Mul(a, M[i][c]))
+				M[k][c].Quo(M[k][c], d)
+			}
+		}
+
+		d = pivot
+		pivots = append(pivots, j)
+		i++
+	}
+
+	return sign, pivots
+}
@@ -0,0 +1,115 @@
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// hilbert returns the n-by-n Hilbert matrix H[i][j] = 1/(i+j+1), which is
+// notoriously ill-conditioned.
+func hilbert(n int) Matrix {
+	return New(n, n, func(i, j int) *big.Rat { return big.NewRat(1, int64(i+j+1)) })
+}
+
+func TestInverse(t *testing.T) {
+	for n := 1; n <= 8; n++ {
+		H := hilbert(n)
+		if HH := Multiply(H, H.Inverse()); !HH.Equals(Identity(n, n)) {
+			t.Fatalf("\nexpected %v\nreceived %v", Identity(n, n), HH)
+		}
+
+		if P := Pow(H, -1).multiply(H); !P.Equals(Identity(n, n)) {
+			t.Fatalf("\nexpected %v\nreceived %v", Identity(n, n), P)
+		}
+	}
+
+	// The inverse of the 3-by-3 Hilbert matrix has integer entries.
+	exp := New(3, 3, func(i, j int) *big.Rat {
+		return big.NewRat([][]int64{{9, -36, 30}, {-36, 192, -180}, {30, -180, 180}}[i][j], 1)
+	})
+
+	if rec := hilbert(3).Inverse(); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	// det(H_4) = 1/6048000
+	if det, exp := hilbert(4).Determinant(), big.NewRat(1, 6048000); det.Cmp(exp) != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, det)
+	}
+
+	// Requires a row swap.
+	A := FromFloat(matrix.Matrix{vector.Vector{0, 1}, vector.Vector{1, 0}})
+	if det, exp := A.Determinant(), big.NewRat(-1, 1); det.Cmp(exp) != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, det)
+	}
+
+	B := FromFloat(matrix.Matrix{vector.Vector{1, 2}, vector.Vector{2, 4}})
+	if det := B.Determinant(); det.Sign() != 0 {
+		t.Fatalf("\nexpected 0\nreceived %v", det)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	var (
+		H = hilbert(6)
+		x = make(Vector, 0, 6)
+	)
+
+	for i := 0; i < 6; i++ {
+		x = append(x, big.NewRat(int64(i+1), 3))
+	}
+
+	y := Multiply(H, New(6, 1, func(i, j int) *big.Rat { return x[i] }))
+	rec := H.Solve(New(6, 1, func(i, j int) *big.Rat { return y[i][0] }).Transpose()[0])
+	for i := range x {
+		if x[i].Cmp(rec[i]) != 0 {
+			t.Fatalf("\nexpected %v\nreceived %v", x, rec)
+		}
+	}
+}
+
+func TestRREF(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for test := 0; test < 50; test++ {
+		// A product of m-by-k and k-by-n matrices has rank at most k.
+		var (
+			m, n, k = 1 + r.Intn(5), 1 + r.Intn(5), 1 + r.Intn(4)
+			gen     = func(i, j int) *big.Rat { return big.NewRat(int64(r.Intn(11)-5), int64(1+r.Intn(4))) }
+			A       = Multiply(New(m, k, gen), New(k, n, gen))
+		)
+
+		R, pivots := A.RREF()
+		F, _ := A.Float()
+		expR, expPivots := F.RREF(1e-9)
+		if len(pivots) != len(expPivots) {
+			t.Fatalf("\nexpected pivots %v\nreceived %v", expPivots, pivots)
+		}
+
+		if G, _ := R.Float(); !G.Approx(expR, 1e-9) {
+			t.Fatalf("\nexpected %v\nreceived %v", expR, R)
+		}
+
+		for i, j := range pivots {
+			if R[i][j].Cmp(big.NewRat(1, 1)) != 0 {
+				t.Fatalf("\nexpected leading one\nreceived %v", R)
+			}
+		}
+	}
+}
+
+func TestFloat(t *testing.T) {
+	A := matrix.Matrix{vector.Vector{0.1, -2.5e-300}, vector.Vector{1e300, 3}}
+	if B, exact := FromFloat(A).Float(); !exact || !B.Equals(A) {
+		t.Fatalf("\nexpected %v\nreceived %v", A, B)
+	}
+
+	if _, exact := hilbert(3).Float(); exact {
+		t.Fatalf("\nexpected 1/3 to be inexact")
+	}
+}
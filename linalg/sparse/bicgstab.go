@@ -0,0 +1,92 @@
+package sparse
+
+import (
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// BiCGSTAB solves Ax=b for x by the right-preconditioned biconjugate gradient
+// stabilized method (Algorithm 7.7). A need not be symmetric. The result is
+// returned even if an error occurs, so the history can be inspected.
+func BiCGSTAB(A Operator, b vector.Vector, s *Settings) (*Result, error) {
+	var (
+		t, x, r = s.init(A, b)
+		n       = len(b)
+		bNorm   = b.Length()
+		res     = &Result{X: x}
+		rHat    = r.Copy()
+		p       = vector.Zero(n)
+		v       = vector.Zero(n)
+		pHat    = vector.Zero(n)
+		sHat    = vector.Zero(n)
+		tv      = vector.Zero(n)
+
+		rho, alpha, omega = 1.0, 1.0, 1.0
+	)
+
+	if bNorm == 0 {
+		// The solution to Ax = 0 is x = 0.
+		res.X = vector.Zero(n)
+		res.History = []float64{0}
+		res.Converged = true
+		return res, nil
+	}
+
+	res.History = append(res.History, r.Length()/bNorm)
+	if res.History[0] <= t.Tol {
+		res.Converged = true
+		return res, nil
+	}
+
+	for res.Iters < t.MaxIter {
+		rhoNext := rHat.Dot(r)
+		if rhoNext == 0 {
+			return res, ErrBreakdown
+		}
+
+		beta := (rhoNext / rho) * (alpha / omega)
+		rho = rhoNext
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+
+		t.Precond.Precondition(pHat, p)
+		A.MultiplyVectorTo(v, pHat)
+		rv := rHat.Dot(v)
+		if rv == 0 {
+			return res, ErrBreakdown
+		}
+
+		// r becomes the intermediate residual s = r - alpha*v.
+		alpha = rho / rv
+		vector.Axpy(alpha, pHat, x)
+		vector.Axpy(-alpha, v, r)
+		res.Iters++
+		if sNorm := r.Length() / bNorm; sNorm <= t.Tol {
+			res.History = append(res.History, sNorm)
+			res.Converged = true
+			return res, nil
+		}
+
+		t.Precond.Precondition(sHat, r)
+		A.MultiplyVectorTo(tv, sHat)
+		tt := tv.Dot(tv)
+		if tt == 0 {
+			return res, ErrBreakdown
+		}
+
+		omega = tv.Dot(r) / tt
+		vector.Axpy(omega, sHat, x)
+		vector.Axpy(-omega, tv, r)
+		res.History = append(res.History, r.Length()/bNorm)
+		if res.History[res.Iters] <= t.Tol {
+			res.Converged = true
+			return res, nil
+		}
+
+		if omega == 0 {
+			return res, ErrBreakdown
+		}
+	}
+
+	return res, ErrNoConvergence
+}
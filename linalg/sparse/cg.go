@@ -0,0 +1,65 @@
+package sparse
+
+import (
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// CG solves Ax=b for x by the preconditioned conjugate gradient method
+// (Algorithm 9.1). A and the preconditioner must be symmetric positive
+// definite. The result is returned even if an error occurs, so the history can
+// be inspected.
+func CG(A Operator, b vector.Vector, s *Settings) (*Result, error) {
+	var (
+		t, x, r = s.init(A, b)
+		n       = len(b)
+		bNorm   = b.Length()
+		res     = &Result{X: x}
+		z       = vector.Zero(n)
+		Ap      = vector.Zero(n)
+	)
+
+	if bNorm == 0 {
+		// The solution to Ax = 0 is x = 0.
+		res.X = vector.Zero(n)
+		res.History = []float64{0}
+		res.Converged = true
+		return res, nil
+	}
+
+	res.History = append(res.History, r.Length()/bNorm)
+	if res.History[0] <= t.Tol {
+		res.Converged = true
+		return res, nil
+	}
+
+	t.Precond.Precondition(z, r)
+	p := z.Copy()
+	rz := r.Dot(z)
+	for res.Iters < t.MaxIter {
+		A.MultiplyVectorTo(Ap, p)
+		pAp := p.Dot(Ap)
+		if pAp <= 0 {
+			return res, ErrBreakdown
+		}
+
+		alpha := rz / pAp
+		vector.Axpy(alpha, p, x)
+		vector.Axpy(-alpha, Ap, r)
+		res.Iters++
+		res.History = append(res.History, r.Length()/bNorm)
+		if res.History[res.Iters] <= t.Tol {
+			res.Converged = true
+			return res, nil
+		}
+
+		t.Precond.Precondition(z, r)
+		rzNext := r.Dot(z)
+		beta := rzNext / rz
+		rz = rzNext
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+
+	return res, ErrNoConvergence
+}
@@ -0,0 +1,99 @@
+package sparse
+
+import "sort"
+
+// COO is a sparse matrix in coordinate format, which is a list of (i,j,a)
+// triples. It is cheap to build one entry at a time but slow to compute with,
+// so it is usually converted to CSR or CSC once built.
+type COO struct {
+	m, n   int
+	rows   []int
+	cols   []int
+	values []float64
+}
+
+// NewCOO returns an empty m-by-n matrix in coordinate format.
+func NewCOO(m, n int) *COO {
+	if m < 0 || n < 0 {
+		panic("dimensions must be non-negative")
+	}
+
+	return &COO{m: m, n: n}
+}
+
+// Append adds a to the (i,j)th entry. Entries appended more than once are
+// summed on conversion.
+func (A *COO) Append(i, j int, a float64) {
+	if i < 0 || A.m <= i || j < 0 || A.n <= j {
+		panic("index out of range")
+	}
+
+	A.rows = append(A.rows, i)
+	A.cols = append(A.cols, j)
+	A.values = append(A.values, a)
+}
+
+// CSR returns A in compressed sparse row format.
+func (A *COO) CSR() *CSR {
+	indptr, indices, values := compress(A.m, A.rows, A.cols, A.values)
+	return &CSR{m: A.m, n: A.n, indptr: indptr, indices: indices, values: values}
+}
+
+// CSC returns A in compressed sparse column format.
+func (A *COO) CSC() *CSC {
+	indptr, indices, values := compress(A.n, A.cols, A.rows, A.values)
+	return &CSC{m: A.m, n: A.n, indptr: indptr, indices: indices, values: values}
+}
+
+// Dimensions returns the number of rows and columns.
+func (A *COO) Dimensions() (int, int) {
+	return A.m, A.n
+}
+
+// NNZ returns the number of stored entries, counting duplicates.
+func (A *COO) NNZ() int {
+	return len(A.values)
+}
+
+// compress sorts the triples (major[k], minor[k], values[k]) into p compressed
+// slices, summing duplicates and dropping zeros. The returned indices are sorted
+// within each slice.
+func compress(p int, major, minor []int, values []float64) ([]int, []int, []float64) {
+	order := make([]int, len(values))
+	for k := range order {
+		order[k] = k
+	}
+
+	sort.Slice(order, func(a, b int) bool {
+		if major[order[a]] != major[order[b]] {
+			return major[order[a]] < major[order[b]]
+		}
+		return minor[order[a]] < minor[order[b]]
+	})
+
+	var (
+		indptr  = make([]int, p+1)
+		indices = make([]int, 0, len(values))
+		vals    = make([]float64, 0, len(values))
+	)
+
+	for a := 0; a < len(order); {
+		i, j := major[order[a]], minor[order[a]]
+		var v float64
+		for ; a < len(order) && major[order[a]] == i && minor[order[a]] == j; a++ {
+			v += values[order[a]]
+		}
+
+		if v != 0 {
+			indices = append(indices, j)
+			vals = append(vals, v)
+			indptr[i+1]++
+		}
+	}
+
+	for i := 0; i < p; i++ {
+		indptr[i+1] += indptr[i]
+	}
+
+	return indptr, indices, vals
+}
@@ -0,0 +1,36 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+func TestCOO(t *testing.T) {
+	A := NewCOO(3, 4)
+	A.Append(2, 3, 1)
+	A.Append(0, 1, 2)
+	A.Append(2, 3, 4) // Duplicates are summed
+	A.Append(1, 0, 5)
+	A.Append(1, 2, 1)
+	A.Append(1, 2, -1) // Cancels to zero and is dropped
+
+	exp := matrix.Matrix{
+		vector.Vector{0, 2, 0, 0},
+		vector.Vector{5, 0, 0, 0},
+		vector.Vector{0, 0, 0, 5},
+	}
+
+	if rec := A.CSR().Dense(); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec := A.CSC().Dense(); !rec.Equals(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if nnz := A.CSR().NNZ(); nnz != 3 {
+		t.Fatalf("\nexpected 3\nreceived %d", nnz)
+	}
+}
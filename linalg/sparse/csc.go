@@ -0,0 +1,82 @@
+package sparse
+
+import (
+	"sort"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// CSC is a sparse matrix in compressed sparse column format. The row indices
+// and values of column j are indices[indptr[j]:indptr[j+1]] and
+// values[indptr[j]:indptr[j+1]], with row indices in ascending order.
+type CSC struct {
+	m, n    int
+	indptr  []int
+	indices []int
+	values  []float64
+}
+
+// At returns the (i,j)th entry.
+func (A *CSC) At(i, j int) float64 {
+	if i < 0 || A.m <= i || j < 0 || A.n <= j {
+		panic("index out of range")
+	}
+
+	rows := A.indices[A.indptr[j]:A.indptr[j+1]]
+	if k := sort.SearchInts(rows, i); k < len(rows) && rows[k] == i {
+		return A.values[A.indptr[j]+k]
+	}
+
+	return 0
+}
+
+// CSR returns A in compressed sparse row format.
+func (A *CSC) CSR() *CSR {
+	return A.Transpose().Transpose()
+}
+
+// Dense returns A as a dense matrix.
+func (A *CSC) Dense() matrix.Matrix {
+	return A.Transpose().Dense().Transpose()
+}
+
+// Dimensions returns the number of rows and columns.
+func (A *CSC) Dimensions() (int, int) {
+	return A.m, A.n
+}
+
+// MultiplyVector returns Ax.
+func (A *CSC) MultiplyVector(x vector.Vector) vector.Vector {
+	y := vector.Zero(A.m)
+	A.MultiplyVectorTo(y, x)
+	return y
+}
+
+// MultiplyVectorTo stores Ax in y.
+func (A *CSC) MultiplyVectorTo(y, x vector.Vector) {
+	if A.n != len(x) || A.m != len(y) {
+		panic("dimension mismatch")
+	}
+
+	for i := range y {
+		y[i] = 0
+	}
+
+	for j := 0; j < A.n; j++ {
+		for p := A.indptr[j]; p < A.indptr[j+1]; p++ {
+			y[A.indices[p]] += A.values[p] * x[j]
+		}
+	}
+}
+
+// NNZ returns the number of stored entries.
+func (A *CSC) NNZ() int {
+	return len(A.values)
+}
+
+// Transpose returns the transpose of A in compressed sparse row format. The
+// storage is shared with A, so no copying is done.
+func (A *CSC) Transpose() *CSR {
+	return &CSR{m: A.n, n: A.m, indptr: A.indptr, indices: A.indices, values: A.values}
+}
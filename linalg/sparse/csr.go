@@ -0,0 +1,361 @@
+package sparse
+
+import (
+	"sort"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// CSR is a sparse matrix in compressed sparse row format. The column indices
+// and values of row i are indices[indptr[i]:indptr[i+1]] and
+// values[indptr[i]:indptr[i+1]], with column indices in ascending order.
+type CSR struct {
+	m, n    int
+	indptr  []int
+	indices []int
+	values  []float64
+}
+
+// ------------------------------------------------------------------------------
+// CSR CONSTRUCTORS
+// ------------------------------------------------------------------------------
+
+// FromDense returns the non-zero entries of a dense matrix in compressed sparse
+// row format.
+func FromDense(A matrix.Matrix) *CSR {
+	m, n := A.Dimensions()
+	B := &CSR{m: m, n: n, indptr: make([]int, 1, m+1)}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if A[i][j] != 0 {
+				B.indices = append(B.indices, j)
+				B.values = append(B.values, A[i][j])
+			}
+		}
+
+		B.indptr = append(B.indptr, len(B.values))
+	}
+
+	return B
+}
+
+// Identity returns the n-by-n identity matrix.
+func Identity(n int) *CSR {
+	A := &CSR{
+		m:       n,
+		n:       n,
+		indptr:  make([]int, 0, n+1),
+		indices: make([]int, 0, n),
+		values:  make([]float64, 0, n),
+	}
+
+	for i := 0; i < n; i++ {
+		A.indptr = append(A.indptr, i)
+		A.indices = append(A.indices, i)
+		A.values = append(A.values, 1)
+	}
+
+	A.indptr = append(A.indptr, n)
+	return A
+}
+
+// ------------------------------------------------------------------------------
+// OPERATIONS ON CSR MATRICES
+// ------------------------------------------------------------------------------
+// As with dense matrices, A.F(B) updates A and F(A,B) returns a new matrix.
+// ------------------------------------------------------------------------------
+
+// Add returns A+B.
+func Add(A, B *CSR) *CSR {
+	return merge(A, 1, B)
+}
+
+// Add B to A.
+func (A *CSR) Add(B *CSR) {
+	*A = *merge(A, 1, B)
+}
+
+// At returns the (i,j)th entry.
+func (A *CSR) At(i, j int) float64 {
+	if i < 0 || A.m <= i || j < 0 || A.n <= j {
+		panic("index out of range")
+	}
+
+	cols := A.indices[A.indptr[i]:A.indptr[i+1]]
+	if k := sort.SearchInts(cols, j); k < len(cols) && cols[k] == j {
+		return A.values[A.indptr[i]+k]
+	}
+
+	return 0
+}
+
+// Copy returns a deep copy of A.
+func (A *CSR) Copy() *CSR {
+	return &CSR{
+		m:       A.m,
+		n:       A.n,
+		indptr:  append(make([]int, 0, len(A.indptr)), A.indptr...),
+		indices: append(make([]int, 0, len(A.indices)), A.indices...),
+		values:  append(make([]float64, 0, len(A.values)), A.values...),
+	}
+}
+
+// CSC returns A in compressed sparse column format.
+func (A *CSR) CSC() *CSC {
+	T := A.Transpose()
+	return &CSC{m: A.m, n: A.n, indptr: T.indptr, indices: T.indices, values: T.values}
+}
+
+// Dense returns A as a dense matrix.
+func (A *CSR) Dense() matrix.Matrix {
+	B := matrix.Empty(A.m, A.n)
+	for i := 0; i < A.m; i++ {
+		for k := A.indptr[i]; k < A.indptr[i+1]; k++ {
+			B[i][A.indices[k]] = A.values[k]
+		}
+	}
+
+	return B
+}
+
+// Dimensions returns the number of rows and columns.
+func (A *CSR) Dimensions() (int, int) {
+	return A.m, A.n
+}
+
+// merge returns A+aB.
+func merge(A *CSR, a float64, B *CSR) *CSR {
+	if A.m != B.m || A.n != B.n {
+		panic("matrices must have the same number of rows and columns")
+	}
+
+	C := &CSR{
+		m:       A.m,
+		n:       A.n,
+		indptr:  make([]int, 1, A.m+1),
+		indices: make([]int, 0, len(A.indices)+len(B.indices)),
+		values:  make([]float64, 0, len(A.values)+len(B.values)),
+	}
+
+	for i := 0; i < A.m; i++ {
+		var (
+			p, pEnd = A.indptr[i], A.indptr[i+1]
+			q, qEnd = B.indptr[i], B.indptr[i+1]
+		)
+
+		for p < pEnd || q < qEnd {
+			var (
+				j int
+				v float64
+			)
+
+			switch {
+			case q == qEnd || (p < pEnd && A.indices[p] < B.indices[q]):
+				j, v = A.indices[p], A.values[p]
+				p++
+			case p == pEnd || B.indices[q] < A.indices[p]:
+				j, v = B.indices[q], a*B.values[q]
+				q++
+			default:
+				j, v = A.indices[p], A.values[p]+a*B.values[q]
+				p++
+				q++
+			}
+
+			if v != 0 {
+				C.indices = append(C.indices, j)
+				C.values = append(C.values, v)
+			}
+		}
+
+		C.indptr = append(C.indptr, len(C.values))
+	}
+
+	return C
+}
+
+// multiply returns AB using Gustavson's row-by-row algorithm.
+func (A *CSR) multiply(B *CSR) *CSR {
+	if A.n != B.m {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	var (
+		C = &CSR{
+			m:      A.m,
+			n:      B.n,
+			indptr: make([]int, 1, A.m+1),
+		}
+		acc  = make([]float64, B.n) // Dense accumulator for one row of C
+		mark = make([]int, B.n)     // mark[j] = i+1 if column j appears in row i of C
+		cols []int
+	)
+
+	for i := 0; i < A.m; i++ {
+		cols = cols[:0]
+		for p := A.indptr[i]; p < A.indptr[i+1]; p++ {
+			k, a := A.indices[p], A.values[p]
+			for q := B.indptr[k]; q < B.indptr[k+1]; q++ {
+				j := B.indices[q]
+				if mark[j] != i+1 {
+					mark[j] = i + 1
+					acc[j] = 0
+					cols = append(cols, j)
+				}
+
+				acc[j] += a * B.values[q]
+			}
+		}
+
+		sort.Ints(cols)
+		for _, j := range cols {
+			if acc[j] != 0 {
+				C.indices = append(C.indices, j)
+				C.values = append(C.values, acc[j])
+			}
+		}
+
+		C.indptr = append(C.indptr, len(C.values))
+	}
+
+	return C
+}
+
+// Multiply several matrices.
+func Multiply(As ...*CSR) *CSR {
+	switch len(As) {
+	case 0:
+		return nil
+	case 1:
+		return As[0]
+	}
+
+	B := As[0].multiply(As[1])
+	for _, A := range As[2:] {
+		B = B.multiply(A)
+	}
+
+	return B
+}
+
+// MultiplyDense returns AB for a dense matrix B.
+func (A *CSR) MultiplyDense(B matrix.Matrix) matrix.Matrix {
+	m, n := B.Dimensions()
+	if A.n != m {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	C := matrix.Empty(A.m, n)
+	for i := 0; i < A.m; i++ {
+		for p := A.indptr[i]; p < A.indptr[i+1]; p++ {
+			C[i].Add(vector.Multiply(A.values[p], B[A.indices[p]]))
+		}
+	}
+
+	return C
+}
+
+// MultiplyVector returns Ax.
+func (A *CSR) MultiplyVector(x vector.Vector) vector.Vector {
+	y := vector.Zero(A.m)
+	A.MultiplyVectorTo(y, x)
+	return y
+}
+
+// MultiplyVectorTo stores Ax in y.
+func (A *CSR) MultiplyVectorTo(y, x vector.Vector) {
+	if A.n != len(x) || A.m != len(y) {
+		panic("dimension mismatch")
+	}
+
+	for i := 0; i < A.m; i++ {
+		var s float64
+		for p := A.indptr[i]; p < A.indptr[i+1]; p++ {
+			s += A.values[p] * x[A.indices[p]]
+		}
+
+		y[i] = s
+	}
+}
+
+// NNZ returns the number of stored entries.
+func (A *CSR) NNZ() int {
+	return len(A.values)
+}
+
+// ScalarMultiply returns aA.
+func ScalarMultiply(a float64, A *CSR) *CSR {
+	B := A.Copy()
+	B.ScalarMultiply(a)
+	return B
+}
+
+// ScalarMultiply A by a.
+func (A *CSR) ScalarMultiply(a float64) {
+	for k := range A.values {
+		A.values[k] *= a
+	}
+}
+
+// Subtract returns A-B.
+func Subtract(A, B *CSR) *CSR {
+	return merge(A, -1, B)
+}
+
+// Subtract B from A.
+func (A *CSR) Subtract(B *CSR) {
+	*A = *merge(A, -1, B)
+}
+
+// Trace the main or secondary diagonal.
+func (A *CSR) Trace(mainDiagonal bool) float64 {
+	if A.m != A.n {
+		panic("invalid dimensions")
+	}
+
+	var s float64
+	for i := 0; i < A.m; i++ {
+		j := i
+		if !mainDiagonal {
+			j = A.n - i - 1
+		}
+
+		s += A.At(i, j)
+	}
+
+	return s
+}
+
+// Transpose a matrix.
+func (A *CSR) Transpose() *CSR {
+	T := &CSR{
+		m:       A.n,
+		n:       A.m,
+		indptr:  make([]int, A.n+1),
+		indices: make([]int, len(A.indices)),
+		values:  make([]float64, len(A.values)),
+	}
+
+	// Count the entries in each column of A, then scatter the rows of A into
+	// place. Visiting rows in order keeps the indices of T sorted.
+	for _, j := range A.indices {
+		T.indptr[j+1]++
+	}
+
+	for j := 0; j < A.n; j++ {
+		T.indptr[j+1] += T.indptr[j]
+	}
+
+	next := append(make([]int, 0, A.n), T.indptr[:A.n]...)
+	for i := 0; i < A.m; i++ {
+		for p := A.indptr[i]; p < A.indptr[i+1]; p++ {
+			j := A.indices[p]
+			T.indices[next[j]] = i
+			T.values[next[j]] = A.values[p]
+			next[j]++
+		}
+	}
+
+	return T
+}
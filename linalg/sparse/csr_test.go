@@ -0,0 +1,77 @@
+package sparse
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// random returns an m-by-n dense matrix with roughly the given density of
+// small non-zero integer entries.
+func random(r *rand.Rand, m, n int, density float64) matrix.Matrix {
+	return matrix.New(m, n, func(i, j int) float64 {
+		if r.Float64() < density {
+			return float64(r.Intn(19) - 9)
+		}
+		return 0
+	})
+}
+
+func TestCSR(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for test := 0; test < 20; test++ {
+		var (
+			m, k, n = 1 + r.Intn(8), 1 + r.Intn(8), 1 + r.Intn(8)
+			A, B, C = random(r, m, k, 0.3), random(r, m, k, 0.3), random(r, k, n, 0.3)
+			x       = vector.New(k, func(i int) float64 { return float64(i + 1) })
+			SA      = FromDense(A)
+			SB      = FromDense(B)
+			SC      = FromDense(C)
+		)
+
+		if exp, rec := matrix.Add(A, B), Add(SA, SB).Dense(); !exp.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := matrix.Subtract(A, B), Subtract(SA, SB).Dense(); !exp.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := matrix.Multiply(A, C), Multiply(SA, SC).Dense(); !exp.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := matrix.Multiply(A, C), SA.MultiplyDense(C); !exp.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := matrix.Multiply(A, matrix.ColumnMatrix(x)).Vector(), SA.MultiplyVector(x); !exp.Equal(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := matrix.Multiply(A, matrix.ColumnMatrix(x)).Vector(), SA.CSC().MultiplyVector(x); !exp.Equal(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		if exp, rec := A.Transpose(), SA.Transpose().Dense(); !exp.Equals(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				if SA.At(i, j) != A[i][j] || SA.CSC().At(i, j) != A[i][j] {
+					t.Fatalf("\nexpected %v\nreceived %v", A[i][j], SA.At(i, j))
+				}
+			}
+		}
+
+		D := random(r, m, m, 0.5)
+		for _, main := range []bool{true, false} {
+			if exp, rec := D.Trace(main), FromDense(D).Trace(main); exp != rec {
+				t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package sparse
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// GMRES solves Ax=b for x by the right-preconditioned restarted generalized
+// minimal residual method (Algorithm 9.5). A need not be symmetric. The result
+// is returned even if an error occurs, so the history can be inspected.
+func GMRES(A Operator, b vector.Vector, s *Settings) (*Result, error) {
+	var (
+		t, x, r = s.init(A, b)
+		n       = len(b)
+		m       = t.Restart
+		bNorm   = b.Length()
+		res     = &Result{X: x}
+		V       = make([]vector.Vector, m+1) // Orthonormal basis of the Krylov subspace
+		H       = make([]vector.Vector, m+1) // Hessenberg matrix, reduced to upper triangular by Givens rotations
+		cs      = vector.Zero(m)             // Givens rotation cosines
+		sn      = vector.Zero(m)             // Givens rotation sines
+		g       = vector.Zero(m + 1)         // Right-hand side of the least-squares problem
+		z       = vector.Zero(n)
+		w       = vector.Zero(n)
+	)
+
+	if bNorm == 0 {
+		// The solution to Ax = 0 is x = 0.
+		res.X = vector.Zero(n)
+		res.History = []float64{0}
+		res.Converged = true
+		return res, nil
+	}
+
+	for i := range V {
+		V[i] = vector.Zero(n)
+		H[i] = vector.Zero(m)
+	}
+
+	beta := r.Length()
+	res.History = append(res.History, beta/bNorm)
+	if res.History[0] <= t.Tol {
+		res.Converged = true
+		return res, nil
+	}
+
+	for res.Iters < t.MaxIter {
+		for i := range V[0] {
+			V[0][i] = r[i] / beta
+		}
+
+		for i := range g {
+			g[i] = 0
+		}
+
+		g[0] = beta
+		k := 0
+		for k < m && res.Iters < t.MaxIter {
+			// Arnoldi step with modified Gram-Schmidt
+			t.Precond.Precondition(z, V[k])
+			A.MultiplyVectorTo(w, z)
+			for i := 0; i <= k; i++ {
+				H[i][k] = w.Dot(V[i])
+				vector.Axpy(-H[i][k], V[i], w)
+			}
+
+			H[k+1][k] = w.Length()
+			if H[k+1][k] != 0 {
+				for i := range w {
+					V[k+1][i] = w[i] / H[k+1][k]
+				}
+			}
+
+			// Apply the previous rotations to the new column, then choose a
+			// rotation that zeroes H[k+1][k].
+			for i := 0; i < k; i++ {
+				H[i][k], H[i+1][k] = cs[i]*H[i][k]+sn[i]*H[i+1][k], -sn[i]*H[i][k]+cs[i]*H[i+1][k]
+			}
+
+			d := gomath.Hypot(H[k][k], H[k+1][k])
+			if d == 0 {
+				return res, ErrBreakdown
+			}
+
+			cs[k], sn[k] = H[k][k]/d, H[k+1][k]/d
+			H[k][k], H[k+1][k] = d, 0
+			g[k], g[k+1] = cs[k]*g[k], -sn[k]*g[k]
+
+			k++
+			res.Iters++
+			res.History = append(res.History, gomath.Abs(g[k])/bNorm)
+			if res.History[res.Iters] <= t.Tol {
+				break
+			}
+		}
+
+		// Solve the k-by-k upper triangular system Hy = g and update
+		// x = x + M^-1 Vy.
+		y := vector.Zero(k)
+		for i := k - 1; 0 <= i; i-- {
+			y[i] = g[i]
+			for j := i + 1; j < k; j++ {
+				y[i] -= H[i][j] * y[j]
+			}
+
+			y[i] /= H[i][i]
+		}
+
+		for i := range w {
+			w[i] = 0
+		}
+
+		for j := 0; j < k; j++ {
+			vector.Axpy(y[j], V[j], w)
+		}
+
+		t.Precond.Precondition(z, w)
+		vector.Axpy(1, z, x)
+
+		// Restart from the true residual.
+		A.MultiplyVectorTo(r, x)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+
+		beta = r.Length()
+		if beta/bNorm <= t.Tol {
+			res.Converged = true
+			return res, nil
+		}
+	}
+
+	return res, ErrNoConvergence
+}
@@ -0,0 +1,141 @@
+package sparse
+
+import (
+	"errors"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ------------------------------------------------------------------------------
+// RESOURCES
+// ------------------------------------------------------------------------------
+// The iterative methods defined here follow Iterative Methods for Sparse Linear
+// Systems, 2nd Ed., by Yousef Saad. Any algorithm references in comments are in
+// reference to this source.
+// ------------------------------------------------------------------------------
+
+var (
+	// ErrBreakdown is returned when an iterative method cannot continue, such
+	// as when a search direction vanishes or the matrix is not of the kind the
+	// method requires.
+	ErrBreakdown = errors.New("iterative method broke down")
+
+	// ErrNoConvergence is returned when an iterative method reaches its
+	// iteration limit before reaching its tolerance. It is the same error as
+	// matrix.ErrNoConvergence.
+	ErrNoConvergence = matrix.ErrNoConvergence
+)
+
+// Operator is a linear map, such as a matrix, that need only be able to
+// compute its product with a vector.
+type Operator interface {
+	// MultiplyVectorTo stores Ax in y.
+	MultiplyVectorTo(y, x vector.Vector)
+}
+
+// OperatorFunc is a function that stores Ax in y.
+type OperatorFunc func(y, x vector.Vector)
+
+// MultiplyVectorTo calls f(y, x).
+func (f OperatorFunc) MultiplyVectorTo(y, x vector.Vector) {
+	f(y, x)
+}
+
+// DenseOperator is a dense matrix viewed as an operator.
+type DenseOperator matrix.Matrix
+
+// MultiplyVectorTo stores Ax in y.
+func (A DenseOperator) MultiplyVectorTo(y, x vector.Vector) {
+	if len(A) != len(y) {
+		panic("dimension mismatch")
+	}
+
+	for i, r := range A {
+		y[i] = r.Dot(x)
+	}
+}
+
+// Settings configures an iterative solver. The zero value is ready to use.
+type Settings struct {
+	// Tol is the relative residual |b-Ax|/|b| at which to stop. If zero, 1e-10
+	// is used.
+	Tol float64
+
+	// MaxIter is the maximum number of iterations. If zero, 10n is used for an
+	// n-dimensional system.
+	MaxIter int
+
+	// Restart is the number of iterations GMRES takes before restarting. If
+	// zero, min(n,30) is used. It is ignored by other methods.
+	Restart int
+
+	// Precond is an optional preconditioner.
+	Precond Preconditioner
+
+	// X0 is the initial guess. If nil, the zero vector is used.
+	X0 vector.Vector
+}
+
+// Result is the outcome of an iterative solve.
+type Result struct {
+	// X is the final iterate.
+	X vector.Vector
+
+	// Iters is the number of iterations taken.
+	Iters int
+
+	// History holds the relative residual |b-Ax|/|b| before the first
+	// iteration and after each iteration. For GMRES, the values within a
+	// restart cycle are the estimates the method minimizes.
+	History []float64
+
+	// Converged indicates the tolerance was reached.
+	Converged bool
+}
+
+// init returns the settings with defaults filled in for an n-dimensional
+// system, along with the initial guess and residual r = b-Ax.
+func (s *Settings) init(A Operator, b vector.Vector) (Settings, vector.Vector, vector.Vector) {
+	n := len(b)
+	var t Settings
+	if s != nil {
+		t = *s
+	}
+
+	if t.Tol == 0 {
+		t.Tol = 1e-10
+	}
+
+	if t.MaxIter == 0 {
+		t.MaxIter = 10 * n
+	}
+
+	if t.Restart == 0 {
+		t.Restart = 30
+		if n < t.Restart {
+			t.Restart = n
+		}
+	}
+
+	if t.Precond == nil {
+		t.Precond = identity{}
+	}
+
+	x := vector.Zero(n)
+	if t.X0 != nil {
+		if len(t.X0) != n {
+			panic("dimension mismatch")
+		}
+
+		copy(x, t.X0)
+	}
+
+	r := vector.Zero(n)
+	A.MultiplyVectorTo(r, x)
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+
+	return t, x, r
+}
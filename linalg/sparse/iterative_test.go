@@ -0,0 +1,105 @@
+package sparse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// poisson returns the n-by-n matrix of the finite-difference Laplacian on a line,
+// which is symmetric positive definite. If c is non-zero, a convection term
+// makes it non-symmetric.
+func poisson(n int, c float64) *CSR {
+	A := NewCOO(n, n)
+	for i := 0; i < n; i++ {
+		A.Append(i, i, 2)
+		if 0 < i {
+			A.Append(i, i-1, -1-c)
+		}
+
+		if i+1 < n {
+			A.Append(i, i+1, -1+c)
+		}
+	}
+
+	return A.CSR()
+}
+
+func TestIterative(t *testing.T) {
+	type solver func(Operator, vector.Vector, *Settings) (*Result, error)
+	var (
+		n       = 50
+		x       = vector.New(n, func(i int) float64 { return float64(i%7) - 3 })
+		solvers = map[string]solver{"CG": CG, "GMRES": GMRES, "BiCGSTAB": BiCGSTAB}
+	)
+
+	for _, c := range []float64{0, 0.3} {
+		A := poisson(n, c)
+		b := A.MultiplyVector(x)
+		jacobi, err := NewJacobi(A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ilu, err := NewILU0(A)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for name, solve := range solvers {
+			if name == "CG" && c != 0 {
+				continue // CG requires a symmetric matrix
+			}
+
+			for _, P := range []Preconditioner{nil, jacobi, ilu} {
+				res, err := solve(A, b, &Settings{Tol: 1e-12, Precond: P})
+				if err != nil {
+					t.Fatalf("%s: %v after %d iterations", name, err, res.Iters)
+				}
+
+				if !res.Converged || len(res.History) != res.Iters+1 {
+					t.Fatalf("%s: inconsistent result %+v", name, res)
+				}
+
+				if !res.X.Approx(x, 1e-8) {
+					t.Fatalf("%s:\nexpected %v\nreceived %v", name, x, res.X)
+				}
+			}
+		}
+
+		// ILU(0) of a tridiagonal matrix is its exact LU factorization, so
+		// one iteration suffices.
+		if res, err := GMRES(A, b, &Settings{Precond: ilu}); err != nil || 1 < res.Iters {
+			t.Fatalf("\nexpected 1 iteration\nreceived %d (%v)", res.Iters, err)
+		}
+	}
+
+	A := poisson(n, 0)
+	res, err := CG(A, A.MultiplyVector(x), &Settings{MaxIter: 3})
+	if !errors.Is(err, matrix.ErrNoConvergence) {
+		t.Fatalf("\nexpected %v\nreceived %v", matrix.ErrNoConvergence, err)
+	}
+
+	if res.Converged || len(res.History) != 4 {
+		t.Fatalf("\nexpected 3 iterations of history\nreceived %v", res.History)
+	}
+}
+
+func TestDenseOperator(t *testing.T) {
+	var (
+		A = poisson(5, 0.1)
+		x = vector.Vector{1, 2, 3, 4, 5}
+		b = A.MultiplyVector(x)
+	)
+
+	res, err := GMRES(DenseOperator(A.Dense()), b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !res.X.Approx(x, 1e-8) {
+		t.Fatalf("\nexpected %v\nreceived %v", x, res.X)
+	}
+}
@@ -0,0 +1,138 @@
+package sparse
+
+import (
+	"errors"
+
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// ErrZeroPivot is returned when a factorization encounters a zero on the
+// diagonal.
+var ErrZeroPivot = errors.New("zero pivot")
+
+// Preconditioner approximates the inverse of a matrix M close to A, such that
+// M^-1 A is better conditioned than A.
+type Preconditioner interface {
+	// Precondition stores M^-1 r in z.
+	Precondition(z, r vector.Vector)
+}
+
+// identity is the preconditioner M = I.
+type identity struct{}
+
+// Precondition copies r into z.
+func (identity) Precondition(z, r vector.Vector) {
+	copy(z, r)
+}
+
+// Jacobi is the preconditioner M = diag(A).
+type Jacobi struct {
+	inv vector.Vector // Reciprocals of the diagonal of A
+}
+
+// NewJacobi returns the Jacobi preconditioner of a square matrix A. If A has a
+// zero on its diagonal, ErrZeroPivot is returned.
+func NewJacobi(A *CSR) (*Jacobi, error) {
+	if A.m != A.n {
+		panic("matrix must be square")
+	}
+
+	P := &Jacobi{inv: vector.Zero(A.n)}
+	for i := 0; i < A.n; i++ {
+		d := A.At(i, i)
+		if d == 0 {
+			return nil, ErrZeroPivot
+		}
+
+		P.inv[i] = 1 / d
+	}
+
+	return P, nil
+}
+
+// Precondition stores M^-1 r in z.
+func (P *Jacobi) Precondition(z, r vector.Vector) {
+	for i, d := range P.inv {
+		z[i] = d * r[i]
+	}
+}
+
+// ILU0 is the incomplete LU factorization M = LU of A with no fill-in, so L and
+// U have the same sparsity pattern as A (Algorithm 10.4).
+type ILU0 struct {
+	lu   *CSR  // L below the diagonal (its unit diagonal is implied) and U on and above it
+	diag []int // Position of the diagonal entry of each row in lu
+}
+
+// NewILU0 returns the ILU(0) preconditioner of a square matrix A. Each diagonal
+// entry of A must be stored. If a zero pivot is encountered, ErrZeroPivot is
+// returned.
+func NewILU0(A *CSR) (*ILU0, error) {
+	if A.m != A.n {
+		panic("matrix must be square")
+	}
+
+	var (
+		n   = A.n
+		LU  = A.Copy()
+		pos = make([]int, n) // pos[j] = p+1 if column j of the current row is stored at p
+		P   = &ILU0{lu: LU, diag: make([]int, n)}
+	)
+
+	for i := 0; i < n; i++ {
+		start, end := LU.indptr[i], LU.indptr[i+1]
+		P.diag[i] = -1
+		for p := start; p < end; p++ {
+			pos[LU.indices[p]] = p + 1
+			if LU.indices[p] == i {
+				P.diag[i] = p
+			}
+		}
+
+		if P.diag[i] < 0 {
+			return nil, ErrZeroPivot
+		}
+
+		for p := start; p < P.diag[i]; p++ {
+			k := LU.indices[p]
+			LU.values[p] /= LU.values[P.diag[k]]
+			for q := P.diag[k] + 1; q < LU.indptr[k+1]; q++ {
+				if r := pos[LU.indices[q]]; r != 0 {
+					LU.values[r-1] -= LU.values[p] * LU.values[q]
+				}
+			}
+		}
+
+		if LU.values[P.diag[i]] == 0 {
+			return nil, ErrZeroPivot
+		}
+
+		for p := start; p < end; p++ {
+			pos[LU.indices[p]] = 0
+		}
+	}
+
+	return P, nil
+}
+
+// Precondition stores M^-1 r in z.
+func (P *ILU0) Precondition(z, r vector.Vector) {
+	LU := P.lu
+	for i := 0; i < LU.n; i++ {
+		s := r[i]
+		for p := LU.indptr[i]; p < P.diag[i]; p++ {
+			s -= LU.values[p] * z[LU.indices[p]]
+		}
+
+		z[i] = s
+	}
+
+	for i := LU.n - 1; 0 <= i; i-- {
+		s := z[i]
+		for p := P.diag[i] + 1; p < LU.indptr[i+1]; p++ {
+			s -= LU.values[p] * z[LU.indices[p]]
+		}
+
+		z[i] = s / LU.values[P.diag[i]]
+	}
+}
@@ -0,0 +1,253 @@
+package structured
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math"
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// Banded is a matrix whose non-zero entries lie within kl diagonals below the
+// main diagonal and ku diagonals above it. Row i stores the kl+ku+1 entries in
+// columns i-kl to i+ku, including any that fall outside the matrix.
+type Banded struct {
+	n, kl, ku int
+	data      []float64
+}
+
+// NewBanded returns the n-by-n banded matrix with kl sub-diagonals and ku
+// super-diagonals and entries defined by a generating function f, which is
+// called only for entries within the band.
+func NewBanded(n, kl, ku int, f matrix.Generator) *Banded {
+	if n < 0 || kl < 0 || ku < 0 {
+		panic("dimensions must be non-negative")
+	}
+
+	B := &Banded{n: n, kl: kl, ku: ku, data: make([]float64, n*(kl+ku+1))}
+	for i := 0; i < n; i++ {
+		for j := math.MaxInt(0, i-kl); j <= i+ku && j < n; j++ {
+			B.data[B.index(i, j)] = f(i, j)
+		}
+	}
+
+	return B
+}
+
+// NewTridiagonal returns the n-by-n tridiagonal matrix with a given
+// sub-diagonal, diagonal and super-diagonal, where n = len(diag) and the others
+// have dimension n-1.
+func NewTridiagonal(sub, diag, super vector.Vector) *Banded {
+	n := len(diag)
+	if 0 < n && (len(sub) != n-1 || len(super) != n-1) {
+		panic("dimension mismatch")
+	}
+
+	return NewBanded(n, 1, 1, func(i, j int) float64 {
+		switch j - i {
+		case -1:
+			return sub[j]
+		case 0:
+			return diag[i]
+		default:
+			return super[i]
+		}
+	})
+}
+
+// index returns the index of entry (i,j), which must be within the band.
+func (B *Banded) index(i, j int) int {
+	return i*(B.kl+B.ku+1) + j - i + B.kl
+}
+
+// At returns the (i,j)th entry.
+func (B *Banded) At(i, j int) float64 {
+	if i < 0 || B.n <= i || j < 0 || B.n <= j {
+		panic("index out of range")
+	}
+
+	if j < i-B.kl || i+B.ku < j {
+		return 0
+	}
+
+	return B.data[B.index(i, j)]
+}
+
+// Bandwidths returns the number of sub-diagonals and super-diagonals.
+func (B *Banded) Bandwidths() (int, int) {
+	return B.kl, B.ku
+}
+
+// Dense returns the matrix as a dense matrix.
+func (B *Banded) Dense() matrix.Matrix {
+	return dense(B)
+}
+
+// Dimensions returns the number of rows and columns.
+func (B *Banded) Dimensions() (int, int) {
+	return B.n, B.n
+}
+
+// MultiplyVector returns Bx.
+func (B *Banded) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(B, x)
+}
+
+// MultiplyVectorTo stores Bx in y.
+func (B *Banded) MultiplyVectorTo(y, x vector.Vector) {
+	checkDimensions(B.n, x, y)
+	z := vector.Zero(B.n)
+	for i := range z {
+		for j := math.MaxInt(0, i-B.kl); j <= i+B.ku && j < B.n; j++ {
+			z[i] += B.data[B.index(i, j)] * x[j]
+		}
+	}
+
+	copy(y, z)
+}
+
+// Solve Bx=y for x. Diagonally dominant tridiagonal matrices are solved by the
+// Thomas algorithm in O(n) time. The Thomas algorithm does not pivot and is
+// not stable for other matrices, so they, and matrices that are not
+// tridiagonal, are solved by Gaussian elimination with partial pivoting within
+// the band instead, in O(n*kl*(kl+ku)) time.
+func (B *Banded) Solve(y vector.Vector) (vector.Vector, error) {
+	if B.n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	if B.kl == 1 && B.ku == 1 && B.isDiagonallyDominant() {
+		if x, ok := B.thomas(y); ok {
+			return x, nil
+		}
+	}
+
+	return B.eliminate(y)
+}
+
+// isDiagonallyDominant returns true if each diagonal entry is at least as large
+// in absolute value as the sum of the others in its row.
+func (B *Banded) isDiagonallyDominant() bool {
+	for i := 0; i < B.n; i++ {
+		var s float64
+		for j := math.MaxInt(0, i-B.kl); j <= i+B.ku && j < B.n; j++ {
+			if j != i {
+				s += gomath.Abs(B.data[B.index(i, j)])
+			}
+		}
+
+		if gomath.Abs(B.data[B.index(i, i)]) < s {
+			return false
+		}
+	}
+
+	return true
+}
+
+// thomas returns the solution of Bx=y for tridiagonal B by the Thomas
+// algorithm, which is LU decomposition without pivoting. It returns false if a
+// pivot is zero.
+func (B *Banded) thomas(y vector.Vector) (vector.Vector, bool) {
+	var (
+		n = B.n
+		c = vector.Zero(n) // Modified super-diagonal
+		x = y.Copy()
+	)
+
+	for i := 0; i < n; i++ {
+		pivot := B.data[B.index(i, i)]
+		if 0 < i {
+			a := B.data[B.index(i, i-1)]
+			pivot -= a * c[i-1]
+			x[i] -= a * x[i-1]
+		}
+
+		if pivot == 0 {
+			return nil, false
+		}
+
+		if i+1 < n {
+			c[i] = B.data[B.index(i, i+1)] / pivot
+		}
+
+		x[i] /= pivot
+	}
+
+	for i := n - 2; 0 <= i; i-- {
+		x[i] -= c[i] * x[i+1]
+	}
+
+	return x, true
+}
+
+// eliminate returns the solution of Bx=y by Gaussian elimination with partial
+// pivoting. Swapping rows widens the upper band to kl+ku diagonals, so each row
+// is copied into a window of columns i-kl to i+kl+ku.
+func (B *Banded) eliminate(y vector.Vector) (vector.Vector, error) {
+	var (
+		n, kl = B.n, B.kl
+		ku    = B.kl + B.ku // Upper bandwidth after pivoting
+		width = kl + ku + 1
+		w     = make([]float64, n*width)
+		x     = y.Copy()
+	)
+
+	// at returns a pointer to entry (i,j) of the working matrix, for
+	// i-kl <= j <= i+ku.
+	at := func(i, j int) *float64 {
+		return &w[i*width+j-i+kl]
+	}
+
+	for i := 0; i < n; i++ {
+		for j := math.MaxInt(0, i-B.kl); j <= i+B.ku && j < n; j++ {
+			*at(i, j) = B.data[B.index(i, j)]
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		last := math.MinInt(n-1, k+kl) // Last row with an entry in column k
+		p := k
+		for i := k + 1; i <= last; i++ {
+			if gomath.Abs(*at(p, k)) < gomath.Abs(*at(i, k)) {
+				p = i
+			}
+		}
+
+		if *at(p, k) == 0 {
+			return nil, ErrSingular
+		}
+
+		end := math.MinInt(n-1, k+ku) // Last column with an entry in row k
+		if p != k {
+			for j := k; j <= end; j++ {
+				a, b := at(k, j), at(p, j)
+				*a, *b = *b, *a
+			}
+
+			x[k], x[p] = x[p], x[k]
+		}
+
+		for i := k + 1; i <= last; i++ {
+			f := *at(i, k) / *at(k, k)
+			if f == 0 {
+				continue
+			}
+
+			for j := k + 1; j <= end; j++ {
+				*at(i, j) -= f * *at(k, j)
+			}
+
+			x[i] -= f * x[k]
+		}
+	}
+
+	for i := n - 1; 0 <= i; i-- {
+		for j := i + 1; j <= i+ku && j < n; j++ {
+			x[i] -= *at(i, j) * x[j]
+		}
+
+		x[i] /= *at(i, i)
+	}
+
+	return x, nil
+}
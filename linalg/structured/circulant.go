@@ -0,0 +1,113 @@
+package structured
+
+import (
+	gomath "math"
+	gocmplx "math/cmplx"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// Circulant is a matrix in which each column is the previous column rotated
+// down by one entry, so it is defined by its first column c, with (i,j)th entry
+// c[(i-j) mod n]. Its eigenvalues are the discrete Fourier transform of c,
+// which diagonalizes it, so it multiplies and solves in O(n log n) time.
+type Circulant struct {
+	c      vector.Vector
+	values []complex128 // Eigenvalues, the transform of c
+}
+
+// NewCirculant returns the circulant matrix with first column c.
+func NewCirculant(c vector.Vector) *Circulant {
+	return &Circulant{c: c.Copy(), values: fft(toComplex(c), false)}
+}
+
+// toComplex returns the entries of v as complex numbers.
+func toComplex(v vector.Vector) []complex128 {
+	z := make([]complex128, 0, len(v))
+	for _, x := range v {
+		z = append(z, complex(x, 0))
+	}
+
+	return z
+}
+
+// At returns the (i,j)th entry.
+func (C *Circulant) At(i, j int) float64 {
+	n := len(C.c)
+	if i < 0 || n <= i || j < 0 || n <= j {
+		panic("index out of range")
+	}
+
+	return C.c[(i-j+n)%n]
+}
+
+// Dense returns the matrix as a dense matrix.
+func (C *Circulant) Dense() matrix.Matrix {
+	return dense(C)
+}
+
+// Dimensions returns the number of rows and columns.
+func (C *Circulant) Dimensions() (int, int) {
+	return len(C.c), len(C.c)
+}
+
+// Eigenvalues returns the eigenvalues of C. The k-th is the sum over j of
+// c[j]*e^(-2 pi i jk/n), with the k-th Fourier mode as its eigenvector.
+func (C *Circulant) Eigenvalues() []complex128 {
+	return append(make([]complex128, 0, len(C.values)), C.values...)
+}
+
+// MultiplyVector returns Cx.
+func (C *Circulant) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(C, x)
+}
+
+// MultiplyVectorTo stores Cx in y.
+func (C *Circulant) MultiplyVectorTo(y, x vector.Vector) {
+	n := len(C.c)
+	checkDimensions(n, x, y)
+	if n == 0 {
+		return
+	}
+
+	z := fft(toComplex(x), false)
+	for k := range z {
+		z[k] *= C.values[k]
+	}
+
+	for i, w := range fft(z, true) {
+		y[i] = real(w)
+	}
+}
+
+// Solve Cx=y for x, dividing by the eigenvalues of C in the Fourier basis. C is
+// treated as singular if an eigenvalue is less than n*eps times the largest in
+// modulus, where eps is the machine epsilon.
+func (C *Circulant) Solve(y vector.Vector) (vector.Vector, error) {
+	n := len(C.c)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	var max float64
+	for _, v := range C.values {
+		max = gomath.Max(max, gocmplx.Abs(v))
+	}
+
+	z := fft(toComplex(y), false)
+	for k, v := range C.values {
+		if gocmplx.Abs(v) <= float64(n)*epsilon*max {
+			return nil, ErrSingular
+		}
+
+		z[k] /= v
+	}
+
+	x := vector.Zero(n)
+	for i, w := range fft(z, true) {
+		x[i] = real(w)
+	}
+
+	return x, nil
+}
@@ -0,0 +1,71 @@
+package structured
+
+import (
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// Diagonal is a matrix whose entries off the main diagonal are zero.
+type Diagonal struct {
+	d vector.Vector
+}
+
+// NewDiagonal returns the diagonal matrix with a given diagonal.
+func NewDiagonal(d vector.Vector) *Diagonal {
+	return &Diagonal{d: d.Copy()}
+}
+
+// At returns the (i,j)th entry.
+func (D *Diagonal) At(i, j int) float64 {
+	if i == j {
+		return D.d[i]
+	}
+
+	return 0
+}
+
+// Dense returns the matrix as a dense matrix.
+func (D *Diagonal) Dense() matrix.Matrix {
+	return dense(D)
+}
+
+// Diagonal returns a copy of the diagonal.
+func (D *Diagonal) Diagonal() vector.Vector {
+	return D.d.Copy()
+}
+
+// Dimensions returns the number of rows and columns.
+func (D *Diagonal) Dimensions() (int, int) {
+	return len(D.d), len(D.d)
+}
+
+// MultiplyVector returns Dx.
+func (D *Diagonal) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(D, x)
+}
+
+// MultiplyVectorTo stores Dx in y.
+func (D *Diagonal) MultiplyVectorTo(y, x vector.Vector) {
+	checkDimensions(len(D.d), x, y)
+	for i, d := range D.d {
+		y[i] = d * x[i]
+	}
+}
+
+// Solve Dx=y for x.
+func (D *Diagonal) Solve(y vector.Vector) (vector.Vector, error) {
+	if len(D.d) != len(y) {
+		panic("dimension mismatch")
+	}
+
+	x := vector.Zero(len(y))
+	for i, d := range D.d {
+		if d == 0 {
+			return nil, ErrSingular
+		}
+
+		x[i] = y[i] / d
+	}
+
+	return x, nil
+}
@@ -0,0 +1,120 @@
+package structured
+
+import (
+	gomath "math"
+	"math/bits"
+	gocmplx "math/cmplx"
+)
+
+// fft returns the discrete Fourier transform of a, which is the sum over j of
+// a[j]*e^(-2 pi i jk/n), or the inverse transform, scaled by 1/n, if inverse
+// is true. Lengths that are powers of two use the radix-2 Cooley-Tukey
+// algorithm and all others Bluestein's algorithm, so every transform takes
+// O(n log n) time.
+func fft(a []complex128, inverse bool) []complex128 {
+	n := len(a)
+	if n == 0 {
+		return nil
+	}
+
+	var y []complex128
+	if n&(n-1) == 0 {
+		y = radix2(a, inverse)
+	} else {
+		y = bluestein(a, inverse)
+	}
+
+	if inverse {
+		for k := range y {
+			y[k] /= complex(float64(n), 0)
+		}
+	}
+
+	return y
+}
+
+// radix2 returns the unscaled transform of a, whose length is a power of two.
+func radix2(a []complex128, inverse bool) []complex128 {
+	var (
+		n     = len(a)
+		y     = make([]complex128, n)
+		shift = 64 - bits.TrailingZeros(uint(n))
+		sign  = -1.0
+	)
+
+	if inverse {
+		sign = 1
+	}
+
+	// Entries are placed at their bit-reversed indices, then combined in
+	// butterflies of doubling size.
+	for i := range a {
+		y[bits.Reverse64(uint64(i))>>shift] = a[i]
+	}
+
+	if n == 1 {
+		return y
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := gocmplx.Rect(1, sign*2*gomath.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				u, v := y[start+k], w*y[start+k+size/2]
+				y[start+k], y[start+k+size/2] = u+v, u-v
+				w *= step
+			}
+		}
+	}
+
+	return y
+}
+
+// bluestein returns the unscaled transform of a, of any length, by writing it
+// as a convolution, which is computed with transforms of a power-of-two
+// length.
+func bluestein(a []complex128, inverse bool) []complex128 {
+	var (
+		n    = len(a)
+		m    = 1 << bits.Len(uint(2*n-1))
+		sign = -1.0
+	)
+
+	if inverse {
+		sign = 1
+	}
+
+	// jk = (j^2 + k^2 - (k-j)^2)/2, so the transform is the chirp w[k] times
+	// the convolution of a[j]w[j] with conj(w). The exponent is reduced mod 2n
+	// to keep the angle accurate for large n.
+	w := make([]complex128, n)
+	for k := range w {
+		e := (k * k) % (2 * n)
+		w[k] = gocmplx.Rect(1, sign*gomath.Pi*float64(e)/float64(n))
+	}
+
+	u, v := make([]complex128, m), make([]complex128, m)
+	for k := 0; k < n; k++ {
+		u[k] = a[k] * w[k]
+	}
+
+	v[0] = gocmplx.Conj(w[0])
+	for k := 1; k < n; k++ {
+		v[k] = gocmplx.Conj(w[k])
+		v[m-k] = v[k]
+	}
+
+	U, V := radix2(u, false), radix2(v, false)
+	for k := range U {
+		U[k] *= V[k]
+	}
+
+	c := radix2(U, true)
+	y := make([]complex128, n)
+	for k := range y {
+		y[k] = w[k] * c[k] / complex(float64(m), 0)
+	}
+
+	return y
+}
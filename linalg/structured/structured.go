@@ -0,0 +1,87 @@
+// Package structured provides square matrices whose entries follow a pattern,
+// such as diagonal, triangular, banded and Toeplitz matrices. Each stores only
+// the entries its pattern needs and multiplies and solves in less time than a
+// dense matrix of the same dimension.
+package structured
+
+import (
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// epsilon is the machine epsilon for float64.
+const epsilon = 0x1p-52
+
+// ErrSingular is returned when a matrix has no inverse. It is the same error as
+// matrix.ErrSingular.
+var ErrSingular = matrix.ErrSingular
+
+// Matrix is a square matrix with structure. Each Matrix is also a
+// sparse.Operator, so it may be passed to the iterative solvers there.
+type Matrix interface {
+	// At returns the (i,j)th entry.
+	At(i, j int) float64
+
+	// Dense returns the matrix as a dense matrix.
+	Dense() matrix.Matrix
+
+	// Dimensions returns the number of rows and columns.
+	Dimensions() (int, int)
+
+	// MultiplyVector returns Ax.
+	MultiplyVector(x vector.Vector) vector.Vector
+
+	// MultiplyVectorTo stores Ax in y.
+	MultiplyVectorTo(y, x vector.Vector)
+
+	// Solve Ax=y for x.
+	Solve(y vector.Vector) (vector.Vector, error)
+}
+
+// dense returns the n-by-n dense matrix with entries A.At(i,j).
+func dense(A Matrix) matrix.Matrix {
+	n, _ := A.Dimensions()
+	return matrix.New(n, n, A.At)
+}
+
+// MultiplyDense returns AB, multiplying each column of B by A.
+func MultiplyDense(A Matrix, B matrix.Matrix) matrix.Matrix {
+	n, _ := A.Dimensions()
+	m, p := B.Dimensions()
+	if n != m {
+		panic("A and B are of incompatible dimensions")
+	}
+
+	var (
+		C    = matrix.Empty(n, p)
+		x, y = vector.Zero(n), vector.Zero(n)
+	)
+
+	for j := 0; j < p; j++ {
+		for i := 0; i < n; i++ {
+			x[i] = B[i][j]
+		}
+
+		A.MultiplyVectorTo(y, x)
+		for i := 0; i < n; i++ {
+			C[i][j] = y[i]
+		}
+	}
+
+	return C
+}
+
+// multiplyVector returns Ax by way of A.MultiplyVectorTo.
+func multiplyVector(A Matrix, x vector.Vector) vector.Vector {
+	n, _ := A.Dimensions()
+	y := vector.Zero(n)
+	A.MultiplyVectorTo(y, x)
+	return y
+}
+
+// checkDimensions panics if x or y do not have dimension n.
+func checkDimensions(n int, x, y vector.Vector) {
+	if len(x) != n || len(y) != n {
+		panic("dimension mismatch")
+	}
+}
@@ -0,0 +1,261 @@
+package structured
+
+import (
+	gomath "math"
+	"math/rand"
+	"testing"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// structuredMatrices returns one of each structured matrix of dimension n, each
+// non-singular.
+func structuredMatrices(r *rand.Rand, n int) []Matrix {
+	f := func(i, j int) float64 {
+		if i == j {
+			return float64(2*n) + r.Float64()
+		}
+
+		return r.Float64()
+	}
+
+	c, row := vector.Zero(n), vector.Zero(n)
+	for i := range c {
+		c[i], row[i] = r.Float64(), r.Float64()
+	}
+
+	c[0] = float64(2 * n)
+	row[0] = c[0]
+	return []Matrix{
+		NewDiagonal(vector.New(n, func(i int) float64 { return f(i, i) })),
+		NewLowerTriangular(n, f),
+		NewUpperTriangular(n, f),
+		NewBanded(n, 2, 1, f),
+		NewBanded(n, 1, 1, f),
+		NewSymmetric(n, f),
+		NewToeplitz(c, row),
+		NewCirculant(c),
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{1, 5, 70} {
+		for _, A := range structuredMatrices(r, n) {
+			var (
+				D = A.Dense()
+				x = vector.New(n, func(i int) float64 { return r.NormFloat64() })
+				y = matrix.Multiply(D, matrix.ColumnMatrix(x)).Vector()
+			)
+
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if A.At(i, j) != D[i][j] {
+						t.Fatalf("\nexpected %v\nreceived %v", D[i][j], A.At(i, j))
+					}
+				}
+			}
+
+			if rec := A.MultiplyVector(x); !rec.Approx(y, 1e-9) {
+				t.Fatalf("\n%T\nexpected %v\nreceived %v", A, y, rec)
+			}
+
+			// The product may be stored over its argument.
+			z := x.Copy()
+			A.MultiplyVectorTo(z, z)
+			if !z.Approx(y, 1e-9) {
+				t.Fatalf("\n%T\nexpected %v\nreceived %v", A, y, z)
+			}
+
+			rec, err := A.Solve(y)
+			if err != nil {
+				t.Fatalf("%T: %v", A, err)
+			}
+
+			if !rec.Approx(x, 1e-9) {
+				t.Fatalf("\n%T\nexpected %v\nreceived %v", A, x, rec)
+			}
+
+			B := matrix.New(n, 2, func(i, j int) float64 { return float64(i + j) })
+			if exp, rec := matrix.Multiply(D, B), MultiplyDense(A, B); !rec.Approx(exp, 1e-9) {
+				t.Fatalf("\n%T\nexpected %v\nreceived %v", A, exp, rec)
+			}
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	tests := []Matrix{
+		NewDiagonal(vector.Vector{1, 0}),
+		NewLowerTriangular(2, func(i, j int) float64 { return float64(i) }),
+		NewUpperTriangular(2, func(i, j int) float64 { return float64(j) }),
+		NewTridiagonal(vector.Vector{1}, vector.Vector{1, 1}, vector.Vector{1}),
+		NewCirculant(vector.Vector{1, -1, 1, -1}),
+		NewSymmetric(3, func(i, j int) float64 { return float64(3*i + j + 1) }),
+		NewSymmetric(2, func(i, j int) float64 { return 0 }),
+	}
+
+	for _, A := range tests {
+		n, _ := A.Dimensions()
+		if _, err := A.Solve(vector.New(n, func(i int) float64 { return 1 })); err != ErrSingular {
+			t.Fatalf("\n%T\nexpected %v\nreceived %v", A, ErrSingular, err)
+		}
+	}
+}
+
+func TestTridiagonal(t *testing.T) {
+	// The first pivot is zero, so the Thomas algorithm cannot be used, but
+	// the matrix is not singular.
+	var (
+		A = NewTridiagonal(vector.Vector{1, 1}, vector.Vector{0, 2, 3}, vector.Vector{1, 1})
+		x = vector.Vector{1, 2, 3}
+	)
+
+	if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	// Neither matrix is diagonally dominant, and the Thomas algorithm loses
+	// the solution to rounding without pivoting.
+	tests := []struct {
+		A *Banded
+		x vector.Vector
+	}{
+		{
+			A: NewTridiagonal(vector.Vector{1}, vector.Vector{1e-20, 1}, vector.Vector{1}),
+			x: vector.Vector{1, 1},
+		},
+		{
+			A: NewTridiagonal(vector.Vector{1, 1}, vector.Vector{1e-20, 1e-20, 1}, vector.Vector{1, 1}),
+			x: vector.Vector{1, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		if rec, err := test.A.Solve(test.A.MultiplyVector(test.x)); err != nil || !rec.Approx(test.x, 1e-12) {
+			t.Fatalf("\nexpected %v\nreceived %v (%v)", test.x, rec, err)
+		}
+	}
+
+	// Random matrices are rarely dominant.
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{3, 10, 50} {
+		var (
+			f = func() vector.Vector { return vector.New(n-1, func(int) float64 { return r.NormFloat64() }) }
+			A = NewTridiagonal(f(), vector.New(n, func(int) float64 { return r.NormFloat64() }), f())
+			x = vector.New(n, func(int) float64 { return r.NormFloat64() })
+		)
+
+		exp, err := matrix.NewLU(A.Dense()).Solve(A.MultiplyVector(x))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(exp, 1e-9) {
+			t.Fatalf("\nexpected %v\nreceived %v (%v)", exp, rec, err)
+		}
+	}
+}
+
+func TestSymmetric(t *testing.T) {
+	// Every diagonal entry is zero, so neither Cholesky nor LDL^T without
+	// pivoting can be used, but the matrix is not singular.
+	var (
+		A = NewSymmetric(4, func(i, j int) float64 { return float64(j - i) })
+		x = vector.Vector{1, -1, 2, -2}
+	)
+
+	if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{2, 9, 40} {
+		var (
+			A = NewSymmetric(n, func(i, j int) float64 { return r.NormFloat64() })
+			x = vector.New(n, func(i int) float64 { return r.NormFloat64() })
+		)
+
+		if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(x, 1e-9) {
+			t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+		}
+	}
+
+	if rec, err := NewSymmetric(0, nil).Solve(vector.Vector{}); err != nil || len(rec) != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", vector.Vector{}, rec, err)
+	}
+}
+
+func TestToeplitz(t *testing.T) {
+	// The leading 1-by-1 submatrix is zero, so Levinson recursion cannot be
+	// used, but the matrix is not singular.
+	var (
+		A = NewToeplitz(vector.Vector{0, 1, 2}, vector.Vector{0, 3, 4})
+		x = vector.Vector{1, -1, 2}
+	)
+
+	if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	// The leading 1-by-1 submatrix is nearly zero, which makes Levinson
+	// recursion unstable, but the matrix is well conditioned.
+	B := NewToeplitz(vector.Vector{1e-13, 1, 0.5}, vector.Vector{1e-13, 1, 0.3})
+	if rec, err := B.Solve(B.MultiplyVector(x)); err != nil || !rec.Approx(x, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v (%v)", x, rec, err)
+	}
+
+	// Random matrices are rarely dominant.
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{3, 10, 50} {
+		var (
+			c   = vector.New(n, func(int) float64 { return r.NormFloat64() })
+			row = vector.New(n, func(int) float64 { return r.NormFloat64() })
+		)
+
+		row[0] = c[0]
+		var (
+			A = NewToeplitz(c, row)
+			x = vector.New(n, func(int) float64 { return r.NormFloat64() })
+		)
+
+		exp, err := matrix.NewLU(A.Dense()).Solve(A.MultiplyVector(x))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if rec, err := A.Solve(A.MultiplyVector(x)); err != nil || !rec.Approx(exp, 1e-9) {
+			t.Fatalf("\nexpected %v\nreceived %v (%v)", exp, rec, err)
+		}
+	}
+}
+
+func TestFFT(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{1, 2, 3, 8, 12, 17} {
+		a := make([]complex128, n)
+		for i := range a {
+			a[i] = complex(r.NormFloat64(), r.NormFloat64())
+		}
+
+		y := fft(a, false)
+		for k := 0; k < n; k++ {
+			var s complex128
+			for j := 0; j < n; j++ {
+				theta := -2 * gomath.Pi * float64(j*k) / float64(n)
+				s += a[j] * complex(gomath.Cos(theta), gomath.Sin(theta))
+			}
+
+			if d := s - y[k]; 1e-9 < gomath.Hypot(real(d), imag(d)) {
+				t.Fatalf("\nn = %d, k = %d\nexpected %v\nreceived %v", n, k, s, y[k])
+			}
+		}
+
+		for i, z := range fft(y, true) {
+			if d := z - a[i]; 1e-12 < gomath.Hypot(real(d), imag(d)) {
+				t.Fatalf("\nexpected %v\nreceived %v", a[i], z)
+			}
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package structured
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// Symmetric is a matrix equal to its transpose. Only the entries on and above
+// the main diagonal are stored, packed by rows into n(n+1)/2 values.
+type Symmetric struct {
+	n    int
+	data []float64
+}
+
+// NewSymmetric returns the n-by-n symmetric matrix with entries defined by a
+// generating function f, which is called only for i <= j.
+func NewSymmetric(n int, f matrix.Generator) *Symmetric {
+	S := &Symmetric{n: n, data: make([]float64, 0, n*(n+1)/2)}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			S.data = append(S.data, f(i, j))
+		}
+	}
+
+	return S
+}
+
+// At returns the (i,j)th entry.
+func (S *Symmetric) At(i, j int) float64 {
+	if i < 0 || S.n <= i || j < 0 || S.n <= j {
+		panic("index out of range")
+	}
+
+	if j < i {
+		i, j = j, i
+	}
+
+	return S.data[upperIndex(S.n, i, j)]
+}
+
+// Dense returns the matrix as a dense matrix.
+func (S *Symmetric) Dense() matrix.Matrix {
+	return dense(S)
+}
+
+// Dimensions returns the number of rows and columns.
+func (S *Symmetric) Dimensions() (int, int) {
+	return S.n, S.n
+}
+
+// MultiplyVector returns Sx.
+func (S *Symmetric) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(S, x)
+}
+
+// MultiplyVectorTo stores Sx in y.
+func (S *Symmetric) MultiplyVectorTo(y, x vector.Vector) {
+	checkDimensions(S.n, x, y)
+
+	// Each stored entry above the diagonal contributes to two rows, so the
+	// product is accumulated separately in case y and x are the same vector.
+	z := vector.Zero(S.n)
+	for i := 0; i < S.n; i++ {
+		row := S.data[upperIndex(S.n, i, i) : upperIndex(S.n, i, S.n-1)+1]
+		z[i] += row[0] * x[i]
+		for k, a := range row[1:] {
+			j := i + k + 1
+			z[i] += a * x[j]
+			z[j] += a * x[i]
+		}
+	}
+
+	copy(y, z)
+}
+
+// Solve Sx=y for x. S is factored as PSP^T = LDL^T by the Bunch-Kaufman
+// method, where P is a permutation, L is unit lower triangular and D is block
+// diagonal with blocks of order one or two. The factorization is stored packed
+// like S, so no dense copy is made. S is singular if a pivot is no larger than
+// n*eps*max|S|, where eps is the machine epsilon.
+func (S *Symmetric) Solve(y vector.Vector) (vector.Vector, error) {
+	if S.n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	F, err := S.factor()
+	if err != nil {
+		return nil, err
+	}
+
+	return F.solve(y), nil
+}
+
+// ldl is the Bunch-Kaufman factorization of a symmetric matrix. The entries of
+// L below each pivot block and the entries of D are stored packed in a, which
+// is read through at. The kth pivot block is of order two if block[k] is true,
+// in which case it occupies rows k and k+1 and L[k+1][k] is zero.
+type ldl struct {
+	n     int
+	a     []float64
+	perm  []int
+	block []bool
+}
+
+// at returns a pointer to the (i,j)th entry of the packed factorization.
+func (F *ldl) at(i, j int) *float64 {
+	if j < i {
+		i, j = j, i
+	}
+
+	return &F.a[upperIndex(F.n, i, j)]
+}
+
+// swap exchanges rows and columns p and q, where p < q.
+func (F *ldl) swap(p, q int) {
+	for j := 0; j < F.n; j++ {
+		if j != p && j != q {
+			*F.at(p, j), *F.at(q, j) = *F.at(q, j), *F.at(p, j)
+		}
+	}
+
+	*F.at(p, p), *F.at(q, q) = *F.at(q, q), *F.at(p, p)
+	F.perm[p], F.perm[q] = F.perm[q], F.perm[p]
+}
+
+// factor returns the Bunch-Kaufman factorization of S.
+func (S *Symmetric) factor() (*ldl, error) {
+	// alpha bounds the growth of entries in L (Bunch and Kaufman, 1977).
+	alpha := (1 + gomath.Sqrt(17)) / 8
+	F := &ldl{
+		n:     S.n,
+		a:     append([]float64(nil), S.data...),
+		perm:  make([]int, S.n),
+		block: make([]bool, S.n),
+	}
+
+	for i := range F.perm {
+		F.perm[i] = i
+	}
+
+	var max float64
+	for _, a := range S.data {
+		max = gomath.Max(max, gomath.Abs(a))
+	}
+
+	tol := float64(S.n) * epsilon * max
+	for k := 0; k < F.n; {
+		// lambda is the largest entry below the diagonal in column k, and it is in
+		// row r.
+		var (
+			akk    = gomath.Abs(*F.at(k, k))
+			lambda float64
+			r      = k
+		)
+
+		for i := k + 1; i < F.n; i++ {
+			if a := gomath.Abs(*F.at(i, k)); lambda < a {
+				lambda, r = a, i
+			}
+		}
+
+		if gomath.Max(akk, lambda) <= tol {
+			return nil, ErrSingular
+		}
+
+		size := 1
+		if akk < alpha*lambda {
+			// sigma is the largest entry off the diagonal in column r.
+			var sigma float64
+			for j := k; j < F.n; j++ {
+				if j != r {
+					sigma = gomath.Max(sigma, gomath.Abs(*F.at(j, r)))
+				}
+			}
+
+			switch {
+			case alpha*lambda*lambda <= akk*sigma:
+			case alpha*sigma <= gomath.Abs(*F.at(r, r)):
+				F.swap(k, r)
+			default:
+				if r != k+1 {
+					F.swap(k+1, r)
+				}
+
+				size = 2
+			}
+		}
+
+		if size == 1 {
+			d := *F.at(k, k)
+			if gomath.Abs(d) <= tol {
+				return nil, ErrSingular
+			}
+
+			for i := k + 1; i < F.n; i++ {
+				l := *F.at(i, k) / d
+				for j := i; j < F.n; j++ {
+					*F.at(i, j) -= l * *F.at(j, k)
+				}
+			}
+
+			for i := k + 1; i < F.n; i++ {
+				*F.at(i, k) /= d
+			}
+
+			k++
+			continue
+		}
+
+		// Each row below the block is multiplied by the inverse of the block.
+		var (
+			d11 = *F.at(k, k)
+			d21 = *F.at(k+1, k)
+			d22 = *F.at(k+1, k+1)
+			det = d11*d22 - d21*d21
+		)
+
+		if gomath.Abs(det) <= tol*gomath.Max(gomath.Abs(d21), gomath.Max(gomath.Abs(d11), gomath.Abs(d22))) {
+			return nil, ErrSingular
+		}
+
+		for i := k + 2; i < F.n; i++ {
+			var (
+				w1 = *F.at(i, k)
+				w2 = *F.at(i, k+1)
+				l1 = (d22*w1 - d21*w2) / det
+				l2 = (d11*w2 - d21*w1) / det
+			)
+
+			for j := i; j < F.n; j++ {
+				*F.at(i, j) -= l1**F.at(j, k) + l2**F.at(j, k+1)
+			}
+		}
+
+		for i := k + 2; i < F.n; i++ {
+			var (
+				w1 = *F.at(i, k)
+				w2 = *F.at(i, k+1)
+			)
+
+			*F.at(i, k) = (d22*w1 - d21*w2) / det
+			*F.at(i, k+1) = (d11*w2 - d21*w1) / det
+		}
+
+		F.block[k] = true
+		k += 2
+	}
+
+	return F, nil
+}
+
+// solve returns x such that Sx=y, where F is the factorization of S.
+func (F *ldl) solve(y vector.Vector) vector.Vector {
+	// Solve LDL^T z = Py, then x = P^T z.
+	z := vector.Zero(F.n)
+	for i, p := range F.perm {
+		z[i] = y[p]
+	}
+
+	for k := 0; k < F.n; k++ {
+		for i := F.below(k); i < F.n; i++ {
+			z[i] -= *F.at(i, k) * z[k]
+		}
+	}
+
+	for k := 0; k < F.n; k++ {
+		if !F.block[k] {
+			z[k] /= *F.at(k, k)
+			continue
+		}
+
+		var (
+			d11 = *F.at(k, k)
+			d21 = *F.at(k+1, k)
+			d22 = *F.at(k+1, k+1)
+			det = d11*d22 - d21*d21
+		)
+
+		z[k], z[k+1] = (d22*z[k]-d21*z[k+1])/det, (d11*z[k+1]-d21*z[k])/det
+		k++
+	}
+
+	for k := F.n - 1; 0 <= k; k-- {
+		for i := F.below(k); i < F.n; i++ {
+			z[k] -= *F.at(i, k) * z[i]
+		}
+	}
+
+	x := vector.Zero(F.n)
+	for i, p := range F.perm {
+		x[p] = z[i]
+	}
+
+	return x
+}
+
+// below returns the first row of L below the pivot block containing column k.
+// Below the first column of a block of order two, the next entry is part of D.
+func (F *ldl) below(k int) int {
+	if F.block[k] {
+		return k + 2
+	}
+
+	return k + 1
+}
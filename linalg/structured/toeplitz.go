@@ -0,0 +1,183 @@
+package structured
+
+import (
+	gomath "math"
+
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// toeplitzFFTMin is the dimension at and above which Toeplitz matrices are
+// multiplied through an embedding in a circulant matrix. Below it, the direct
+// product is faster.
+const toeplitzFFTMin = 64
+
+// Toeplitz is a matrix that is constant along each diagonal, so it is defined
+// by its first column c and first row r, with (i,j)th entry c[i-j] if j <= i
+// and r[j-i] otherwise.
+type Toeplitz struct {
+	c, r vector.Vector
+}
+
+// NewToeplitz returns the Toeplitz matrix with first column c and first row r,
+// which must have the same dimension and first entry.
+func NewToeplitz(c, r vector.Vector) *Toeplitz {
+	switch {
+	case len(c) != len(r):
+		panic("dimension mismatch")
+	case 0 < len(c) && c[0] != r[0]:
+		panic("first column and row must share their first entry")
+	}
+
+	return &Toeplitz{c: c.Copy(), r: r.Copy()}
+}
+
+// At returns the (i,j)th entry.
+func (T *Toeplitz) At(i, j int) float64 {
+	n := len(T.c)
+	if i < 0 || n <= i || j < 0 || n <= j {
+		panic("index out of range")
+	}
+
+	if j <= i {
+		return T.c[i-j]
+	}
+
+	return T.r[j-i]
+}
+
+// Dense returns the matrix as a dense matrix.
+func (T *Toeplitz) Dense() matrix.Matrix {
+	return dense(T)
+}
+
+// Dimensions returns the number of rows and columns.
+func (T *Toeplitz) Dimensions() (int, int) {
+	return len(T.c), len(T.c)
+}
+
+// MultiplyVector returns Tx.
+func (T *Toeplitz) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(T, x)
+}
+
+// MultiplyVectorTo stores Tx in y. Large matrices are embedded in a circulant
+// matrix of twice the dimension, which multiplies in O(n log n) time.
+func (T *Toeplitz) MultiplyVectorTo(y, x vector.Vector) {
+	n := len(T.c)
+	checkDimensions(n, x, y)
+	if n < toeplitzFFTMin {
+		z := vector.Zero(n)
+		for i := range z {
+			for j := 0; j < n; j++ {
+				z[i] += T.At(i, j) * x[j]
+			}
+		}
+
+		copy(y, z)
+		return
+	}
+
+	// The circulant matrix with first column [c, 0, r[n-1], ..., r[1]] has T
+	// as its leading n-by-n block.
+	c := vector.Zero(2 * n)
+	copy(c, T.c)
+	for k := 1; k < n; k++ {
+		c[2*n-k] = T.r[k]
+	}
+
+	xx := vector.Zero(2 * n)
+	copy(xx, x)
+	copy(y, NewCirculant(c).MultiplyVector(xx)[:n])
+}
+
+// Solve Tx=y for x by Levinson recursion in O(n^2) time. The recursion solves
+// each leading principal submatrix in turn and is not stable unless those are
+// well conditioned, so its solution is accepted only if the residual |Tx-y| is
+// no more than n*eps*(|T||x|+|y|) in the infinity norm, where eps is the
+// machine epsilon. Otherwise, LU decomposition of the dense matrix is used
+// instead.
+func (T *Toeplitz) Solve(y vector.Vector) (vector.Vector, error) {
+	n := len(T.c)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	if x, ok := T.levinson(y); ok {
+		// The sum of the absolute entries of c and r bounds every row sum.
+		norm := vector.Asum(T.c) + vector.Asum(T.r[1:])
+		r := T.MultiplyVector(x)
+		r.Subtract(y)
+		if r.NormInf() <= float64(n)*epsilon*(norm*x.NormInf()+y.NormInf()) {
+			return x, nil
+		}
+	}
+
+	return matrix.NewLU(T.Dense()).Solve(y)
+}
+
+// levinson returns the solution of Tx=y by Levinson recursion and false if a
+// leading principal submatrix is too close to singular.
+func (T *Toeplitz) levinson(y vector.Vector) (vector.Vector, bool) {
+	n := len(T.c)
+	if n == 0 {
+		return vector.Vector{}, true
+	}
+
+	if T.c[0] == 0 {
+		return nil, false
+	}
+
+	// With T_k the leading k-by-k submatrix, f and b solve T_k f = e_1 and
+	// T_k b = e_k, and x solves T_k x = y[:k]. Each is extended to k+1 by
+	// padding with a zero and correcting the error this leaves in the new
+	// row or column.
+	var (
+		f = vector.Vector{1 / T.c[0]}
+		b = vector.Vector{1 / T.c[0]}
+		x = vector.Vector{y[0] / T.c[0]}
+	)
+
+	for k := 1; k < n; k++ {
+		var ef, eb, ex float64
+		for i := 0; i < k; i++ {
+			ef += T.c[k-i] * f[i]
+			eb += T.r[i+1] * b[i]
+			ex += T.c[k-i] * x[i]
+		}
+
+		d := 1 - ef*eb
+		if gomath.Abs(d) <= epsilon {
+			return nil, false
+		}
+
+		nf, nb := vector.Zero(k+1), vector.Zero(k+1)
+		for i := 0; i <= k; i++ {
+			var fi, bi float64 // f padded at the end and b at the start
+			if i < k {
+				fi = f[i]
+			}
+
+			if 0 < i {
+				bi = b[i-1]
+			}
+
+			nf[i] = (fi - ef*bi) / d
+			nb[i] = (bi - eb*fi) / d
+		}
+
+		f, b = nf, nb
+		x = append(x, 0)
+		for i := range x {
+			x[i] += (y[k] - ex) * b[i]
+		}
+	}
+
+	for _, v := range x {
+		if gomath.IsNaN(v) || gomath.IsInf(v, 0) {
+			return nil, false
+		}
+	}
+
+	return x, true
+}
@@ -0,0 +1,210 @@
+package structured
+
+import (
+	"github.com/nathangreene3/math/linalg/matrix"
+	"github.com/nathangreene3/math/linalg/vector"
+)
+
+// LowerTriangular is a matrix whose entries above the main diagonal are zero.
+// The entries on and below the diagonal are packed by rows into n(n+1)/2
+// values.
+type LowerTriangular struct {
+	n    int
+	data []float64
+}
+
+// UpperTriangular is a matrix whose entries below the main diagonal are zero.
+// The entries on and above the diagonal are packed by rows into n(n+1)/2
+// values.
+type UpperTriangular struct {
+	n    int
+	data []float64
+}
+
+// lowerIndex returns the index of entry (i,j), for j <= i, in the packed lower
+// triangle.
+func lowerIndex(i, j int) int {
+	return i*(i+1)/2 + j
+}
+
+// upperIndex returns the index of entry (i,j), for i <= j, in the packed upper
+// triangle of an n-by-n matrix.
+func upperIndex(n, i, j int) int {
+	return i*n - i*(i-1)/2 + j - i
+}
+
+// NewLowerTriangular returns the n-by-n lower triangular matrix with entries
+// defined by a generating function f, which is called only for j <= i.
+func NewLowerTriangular(n int, f matrix.Generator) *LowerTriangular {
+	L := &LowerTriangular{n: n, data: make([]float64, 0, n*(n+1)/2)}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			L.data = append(L.data, f(i, j))
+		}
+	}
+
+	return L
+}
+
+// NewUpperTriangular returns the n-by-n upper triangular matrix with entries
+// defined by a generating function f, which is called only for i <= j.
+func NewUpperTriangular(n int, f matrix.Generator) *UpperTriangular {
+	U := &UpperTriangular{n: n, data: make([]float64, 0, n*(n+1)/2)}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			U.data = append(U.data, f(i, j))
+		}
+	}
+
+	return U
+}
+
+// At returns the (i,j)th entry.
+func (L *LowerTriangular) At(i, j int) float64 {
+	if i < 0 || L.n <= i || j < 0 || L.n <= j {
+		panic("index out of range")
+	}
+
+	if i < j {
+		return 0
+	}
+
+	return L.data[lowerIndex(i, j)]
+}
+
+// At returns the (i,j)th entry.
+func (U *UpperTriangular) At(i, j int) float64 {
+	if i < 0 || U.n <= i || j < 0 || U.n <= j {
+		panic("index out of range")
+	}
+
+	if j < i {
+		return 0
+	}
+
+	return U.data[upperIndex(U.n, i, j)]
+}
+
+// Dense returns the matrix as a dense matrix.
+func (L *LowerTriangular) Dense() matrix.Matrix {
+	return dense(L)
+}
+
+// Dense returns the matrix as a dense matrix.
+func (U *UpperTriangular) Dense() matrix.Matrix {
+	return dense(U)
+}
+
+// Dimensions returns the number of rows and columns.
+func (L *LowerTriangular) Dimensions() (int, int) {
+	return L.n, L.n
+}
+
+// Dimensions returns the number of rows and columns.
+func (U *UpperTriangular) Dimensions() (int, int) {
+	return U.n, U.n
+}
+
+// MultiplyVector returns Lx.
+func (L *LowerTriangular) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(L, x)
+}
+
+// MultiplyVector returns Ux.
+func (U *UpperTriangular) MultiplyVector(x vector.Vector) vector.Vector {
+	return multiplyVector(U, x)
+}
+
+// MultiplyVectorTo stores Lx in y.
+func (L *LowerTriangular) MultiplyVectorTo(y, x vector.Vector) {
+	checkDimensions(L.n, x, y)
+
+	// Going from the last row up lets y and x be the same vector.
+	for i := L.n - 1; 0 <= i; i-- {
+		var (
+			row = L.data[lowerIndex(i, 0) : lowerIndex(i, i)+1]
+			s   float64
+		)
+
+		for j, a := range row {
+			s += a * x[j]
+		}
+
+		y[i] = s
+	}
+}
+
+// MultiplyVectorTo stores Ux in y.
+func (U *UpperTriangular) MultiplyVectorTo(y, x vector.Vector) {
+	checkDimensions(U.n, x, y)
+
+	// Going from the first row down lets y and x be the same vector.
+	for i := 0; i < U.n; i++ {
+		var (
+			row = U.data[upperIndex(U.n, i, i) : upperIndex(U.n, i, U.n-1)+1]
+			s   float64
+		)
+
+		for k, a := range row {
+			s += a * x[i+k]
+		}
+
+		y[i] = s
+	}
+}
+
+// Solve Lx=y for x by forward substitution.
+func (L *LowerTriangular) Solve(y vector.Vector) (vector.Vector, error) {
+	if L.n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	x := y.Copy()
+	for i := 0; i < L.n; i++ {
+		row := L.data[lowerIndex(i, 0) : lowerIndex(i, i)+1]
+		if row[i] == 0 {
+			return nil, ErrSingular
+		}
+
+		for j, a := range row[:i] {
+			x[i] -= a * x[j]
+		}
+
+		x[i] /= row[i]
+	}
+
+	return x, nil
+}
+
+// Solve Ux=y for x by back substitution.
+func (U *UpperTriangular) Solve(y vector.Vector) (vector.Vector, error) {
+	if U.n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	x := y.Copy()
+	for i := U.n - 1; 0 <= i; i-- {
+		row := U.data[upperIndex(U.n, i, i) : upperIndex(U.n, i, U.n-1)+1]
+		if row[0] == 0 {
+			return nil, ErrSingular
+		}
+
+		for k, a := range row[1:] {
+			x[i] -= a * x[i+k+1]
+		}
+
+		x[i] /= row[0]
+	}
+
+	return x, nil
+}
+
+// Transpose returns the transpose of L, which is upper triangular.
+func (L *LowerTriangular) Transpose() *UpperTriangular {
+	return NewUpperTriangular(L.n, func(i, j int) float64 { return L.data[lowerIndex(j, i)] })
+}
+
+// Transpose returns the transpose of U, which is lower triangular.
+func (U *UpperTriangular) Transpose() *LowerTriangular {
+	return NewLowerTriangular(U.n, func(i, j int) float64 { return U.data[upperIndex(U.n, j, i)] })
+}
@@ -0,0 +1,101 @@
+package vector
+
+// epsilon is the machine epsilon for float64.
+const epsilon = 0x1p-52
+
+// dimension returns the common dimension of several vectors, which is zero if
+// there are none.
+func dimension(vs []Vector) int {
+	if len(vs) == 0 {
+		return 0
+	}
+
+	n := len(vs[0])
+	for _, v := range vs {
+		if n != len(v) {
+			panic("dimension mismatch")
+		}
+	}
+
+	return n
+}
+
+// GramSchmidt returns an orthonormal basis of the span of several vectors using
+// the modified Gram-Schmidt process. Each vector is orthogonalized against the
+// basis vectors found before it and is dropped if no more than tol of its
+// length remains, so there is one basis vector for each vector that is
+// linearly independent of those preceding it. If tol is negative, a default of
+// n*eps is used, where n is the dimension and eps is the machine epsilon.
+func GramSchmidt(vs []Vector, tol float64) []Vector {
+	n := dimension(vs)
+	if tol < 0 {
+		tol = float64(n) * epsilon
+	}
+
+	basis := make([]Vector, 0, len(vs))
+	for _, v := range vs {
+		r := v.Length()
+		if r == 0 {
+			continue
+		}
+
+		u := v.Copy()
+		u.Reject(basis)
+		if s := u.Length(); tol*r < s {
+			u.Divide(s)
+			basis = append(basis, u)
+		}
+	}
+
+	return basis
+}
+
+// InSpan returns true if v is a linear combination of several vectors. It is
+// in their span if no more than tol of its length remains after its projection
+// onto them is removed. See GramSchmidt for how tol is chosen if it is
+// negative.
+func InSpan(v Vector, vs []Vector, tol float64) bool {
+	if n := dimension(vs); 0 < len(vs) && n != len(v) {
+		panic("dimension mismatch")
+	}
+
+	if tol < 0 {
+		tol = float64(len(v)) * epsilon
+	}
+
+	u := v.Copy()
+	u.Reject(GramSchmidt(vs, tol))
+	return u.Length() <= tol*v.Length()
+}
+
+// IsLinearlyIndependent returns true if no vector is a linear combination of
+// the others. See GramSchmidt for how tol is used.
+func IsLinearlyIndependent(vs []Vector, tol float64) bool {
+	return len(GramSchmidt(vs, tol)) == len(vs)
+}
+
+// SubspaceProjection returns the projection of w onto the span of several
+// vectors.
+func SubspaceProjection(vs []Vector, w Vector) Vector {
+	if n := dimension(vs); 0 < len(vs) && n != len(w) {
+		panic("dimension mismatch")
+	}
+
+	p := Zero(len(w))
+	for _, q := range GramSchmidt(vs, -1) {
+		p.Add(Multiply(q.Dot(w), q))
+	}
+
+	return p
+}
+
+// Reject removes from v its components along each of several orthonormal
+// vectors, leaving the rejection of v from their span.
+func (v Vector) Reject(basis []Vector) {
+	// Orthogonalizing twice guards against cancellation.
+	for pass := 0; pass < 2; pass++ {
+		for _, q := range basis {
+			Axpy(-q.Dot(v), q, v)
+		}
+	}
+}
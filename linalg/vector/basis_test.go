@@ -0,0 +1,120 @@
+package vector
+
+import (
+	gomath "math"
+	"testing"
+)
+
+func TestGramSchmidt(t *testing.T) {
+	vs := []Vector{
+		{1, 1, 0},
+		{2, 2, 0}, // Multiple of the first
+		{1, 0, 1},
+		{0, 0, 0},
+		{0, 1, -1}, // Difference of the first and third
+		{0, 0, 1},
+	}
+
+	basis := GramSchmidt(vs, -1)
+	if len(basis) != 3 {
+		t.Fatalf("\nexpected %d vectors\nreceived %v", 3, basis)
+	}
+
+	for i := range basis {
+		for j := range basis {
+			var exp float64
+			if i == j {
+				exp = 1
+			}
+
+			if rec := basis[i].Dot(basis[j]); 1e-12 < gomath.Abs(rec-exp) {
+				t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+			}
+		}
+	}
+
+	if exp := (Vector{1 / gomath.Sqrt2, 1 / gomath.Sqrt2, 0}); !basis[0].Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, basis[0])
+	}
+
+	if vs[1][0] != 2 {
+		t.Fatalf("\nexpected vectors unchanged\nreceived %v", vs)
+	}
+}
+
+func TestIsLinearlyIndependent(t *testing.T) {
+	tests := []struct {
+		vs  []Vector
+		exp bool
+	}{
+		{vs: nil, exp: true},
+		{vs: []Vector{{1, 0}, {0, 1}}, exp: true},
+		{vs: []Vector{{1, 2}, {2, 4}}, exp: false},
+		{vs: []Vector{{1, 0}, {0, 0}}, exp: false},
+		{vs: []Vector{{1, 0, 0}, {1, 1, 0}, {1, 1, 1}}, exp: true},
+		{vs: []Vector{{1, 0}, {0, 1}, {1, 1}}, exp: false},
+		{vs: []Vector{{1, 0}, {1, 1e-20}}, exp: false},
+	}
+
+	for _, test := range tests {
+		if rec := IsLinearlyIndependent(test.vs, -1); rec != test.exp {
+			t.Fatalf("\nexpected %t\nreceived %t for %v", test.exp, rec, test.vs)
+		}
+	}
+}
+
+func TestInSpan(t *testing.T) {
+	vs := []Vector{{1, 0, 1}, {0, 1, 1}}
+	tests := []struct {
+		v   Vector
+		exp bool
+	}{
+		{v: Vector{1, 1, 2}, exp: true},
+		{v: Vector{2, -3, -1}, exp: true},
+		{v: Vector{0, 0, 0}, exp: true},
+		{v: Vector{0, 0, 1}, exp: false},
+		{v: Vector{1, 1, 2.001}, exp: false},
+	}
+
+	for _, test := range tests {
+		if rec := InSpan(test.v, vs, -1); rec != test.exp {
+			t.Fatalf("\nexpected %t\nreceived %t for %v", test.exp, rec, test.v)
+		}
+	}
+
+	if !InSpan(Vector{1, 1, 2.001}, vs, 1e-3) {
+		t.Fatalf("\nexpected %t\nreceived %t", true, false)
+	}
+
+	if InSpan(Vector{1}, nil, -1) {
+		t.Fatalf("\nexpected %t\nreceived %t", false, true)
+	}
+}
+
+func TestReject(t *testing.T) {
+	var (
+		basis = []Vector{{1, 0, 0}, {0, gomath.Sqrt2 / 2, gomath.Sqrt2 / 2}}
+		v     = Vector{3, 4, 5}
+		exp   = Vector{0, -0.5, 0.5}
+	)
+
+	if v.Reject(basis); !v.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, v)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { v.Reject(basis) }); allocs != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", 0, allocs)
+	}
+}
+
+func TestSubspaceProjection(t *testing.T) {
+	var (
+		vs  = []Vector{{1, 0, 0}, {1, 1, 0}}
+		w   = Vector{3, 4, 5}
+		exp = Vector{3, 4, 0}
+	)
+
+	if rec := SubspaceProjection(vs, w); !rec.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
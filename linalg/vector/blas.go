@@ -0,0 +1,180 @@
+package vector
+
+import gomath "math"
+
+// ------------------------------------------------------------------------------
+// LEVEL-1 KERNELS
+// ------------------------------------------------------------------------------
+// These follow BLAS level 1 (https://netlib.org/blas/) for vectors of unit
+// stride. They do not allocate and write their results into vectors given by
+// the caller, which may alias the inputs. Dimensions are checked once, before
+// the loop. Sums and updates are unrolled four times, but Iamax is not, since
+// each comparison depends on the one before it.
+// ------------------------------------------------------------------------------
+
+// nrm2Small and nrm2Big bound the sums of squares that Nrm2 accepts without
+// scaling. Beyond them, squares of entries may have underflowed or overflowed.
+const (
+	nrm2Small = 0x1p-968
+	nrm2Big   = 0x1p968
+)
+
+// Asum returns the sum of the absolute entries of x.
+func Asum(x Vector) float64 {
+	var s0, s1, s2, s3 float64
+	n := len(x) &^ 3
+	for i := 0; i < n; i += 4 {
+		xs := x[i : i+4 : i+4]
+		s0 += gomath.Abs(xs[0])
+		s1 += gomath.Abs(xs[1])
+		s2 += gomath.Abs(xs[2])
+		s3 += gomath.Abs(xs[3])
+	}
+
+	for _, a := range x[n:] {
+		s0 += gomath.Abs(a)
+	}
+
+	return (s0 + s1) + (s2 + s3)
+}
+
+// Axpy adds ax to y.
+func Axpy(a float64, x, y Vector) {
+	AxpyTo(y, a, x, y)
+}
+
+// AxpyTo sets dst to ax+y.
+func AxpyTo(dst Vector, a float64, x, y Vector) {
+	n := len(x)
+	if n != len(y) || n != len(dst) {
+		panic("dimension mismatch")
+	}
+
+	// Reslicing lets the compiler drop bounds checks in the loops.
+	y, dst = y[:n], dst[:n]
+	m := n &^ 3
+	for i := 0; i < m; i += 4 {
+		xs, ys, ds := x[i:i+4:i+4], y[i:i+4:i+4], dst[i:i+4:i+4]
+		ds[0] = a*xs[0] + ys[0]
+		ds[1] = a*xs[1] + ys[1]
+		ds[2] = a*xs[2] + ys[2]
+		ds[3] = a*xs[3] + ys[3]
+	}
+
+	for i := m; i < n; i++ {
+		dst[i] = a*x[i] + y[i]
+	}
+}
+
+// DotUnitary returns x dot y.
+func DotUnitary(x, y Vector) float64 {
+	n := len(x)
+	if n != len(y) {
+		panic("dimension mismatch")
+	}
+
+	y = y[:n]
+	var s0, s1, s2, s3 float64
+	m := n &^ 3
+	for i := 0; i < m; i += 4 {
+		xs, ys := x[i:i+4:i+4], y[i:i+4:i+4]
+		s0 += xs[0] * ys[0]
+		s1 += xs[1] * ys[1]
+		s2 += xs[2] * ys[2]
+		s3 += xs[3] * ys[3]
+	}
+
+	for i := m; i < n; i++ {
+		s0 += x[i] * y[i]
+	}
+
+	return (s0 + s1) + (s2 + s3)
+}
+
+// Iamax returns the index of the first entry of x with the largest absolute
+// value.
+func Iamax(x Vector) int {
+	if len(x) == 0 {
+		panic("vector must have at least one entry")
+	}
+
+	k, max := 0, gomath.Abs(x[0])
+	for i, a := range x {
+		if a = gomath.Abs(a); max < a {
+			k, max = i, a
+		}
+	}
+
+	return k
+}
+
+// Nrm2 returns the Euclidean length of x. It does not overflow or lose
+// precision to underflow unless the result does.
+func Nrm2(x Vector) float64 {
+	var s0, s1, s2, s3 float64
+	n := len(x) &^ 3
+	for i := 0; i < n; i += 4 {
+		xs := x[i : i+4 : i+4]
+		s0 += xs[0] * xs[0]
+		s1 += xs[1] * xs[1]
+		s2 += xs[2] * xs[2]
+		s3 += xs[3] * xs[3]
+	}
+
+	for _, a := range x[n:] {
+		s0 += a * a
+	}
+
+	// The unscaled sum is exact enough unless squaring under- or overflowed,
+	// which is rare. Then the sum of squares is kept as scale^2 * ssq, where
+	// scale is the largest absolute entry seen so far (LAPACK's dlassq).
+	if s := (s0 + s1) + (s2 + s3); nrm2Small <= s && s <= nrm2Big || gomath.IsNaN(s) {
+		return gomath.Sqrt(s)
+	}
+
+	var scale, ssq float64 = 0, 1
+	for _, a := range x {
+		switch {
+		case a == 0:
+			continue
+		case gomath.IsInf(a, 0):
+			return gomath.Inf(1)
+		}
+
+		if a = gomath.Abs(a); scale < a {
+			ssq = 1 + ssq*(scale/a)*(scale/a)
+			scale = a
+		} else {
+			ssq += (a / scale) * (a / scale)
+		}
+	}
+
+	return scale * gomath.Sqrt(ssq)
+}
+
+// Scal multiplies x by a.
+func Scal(a float64, x Vector) {
+	ScalTo(x, a, x)
+}
+
+// ScalTo sets dst to ax.
+func ScalTo(dst Vector, a float64, x Vector) {
+	n := len(x)
+	if n != len(dst) {
+		panic("dimension mismatch")
+	}
+
+	dst = dst[:n]
+	m := n &^ 3
+	for i := 0; i < m; i += 4 {
+		xs, ds := x[i:i+4:i+4], dst[i:i+4:i+4]
+		ds[0] = a * xs[0]
+		ds[1] = a * xs[1]
+		ds[2] = a * xs[2]
+		ds[3] = a * xs[3]
+	}
+
+	for i := m; i < n; i++ {
+		dst[i] = a * x[i]
+	}
+}
@@ -0,0 +1,231 @@
+package vector
+
+import (
+	gomath "math"
+	"math/rand"
+	"testing"
+)
+
+func TestAsum(t *testing.T) {
+	if exp, rec := 15.0, Asum(Vector{1, -2, 3, -4, 5}); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestAxpy(t *testing.T) {
+	var (
+		x = Vector{1, 2, 3, 4, 5}
+		y = Vector{5, 4, 3, 2, 1}
+	)
+
+	dst := Zero(5)
+	AxpyTo(dst, 2, x, y)
+	if exp := (Vector{7, 8, 9, 10, 11}); !dst.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, dst)
+	}
+
+	Axpy(-1, x, y)
+	if exp := (Vector{4, 2, 0, -2, -4}); !y.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, y)
+	}
+}
+
+func TestDotUnitary(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for _, n := range []int{0, 1, 4, 7, 100} {
+		x := New(n, func(int) float64 { return float64(r.Intn(10)) })
+		y := New(n, func(int) float64 { return float64(r.Intn(10)) })
+		if exp, rec := x.Dot(y), DotUnitary(x, y); exp != rec {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+	}
+}
+
+func TestIamax(t *testing.T) {
+	if exp, rec := 2, Iamax(Vector{1, -2, -5, 5, 3}); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+}
+
+func TestNrm2(t *testing.T) {
+	tests := []struct {
+		x   Vector
+		exp float64
+	}{
+		{x: Vector{}, exp: 0},
+		{x: Vector{3, 4}, exp: 5},
+		{x: Vector{1, 2, 2, 4, 4, 2, 2, 1}, exp: 5 * gomath.Sqrt2},
+		{x: Vector{3e300, 4e300}, exp: 5e300},
+		{x: Vector{3e-300, 4e-300}, exp: 5e-300},
+		{x: Vector{1, gomath.Inf(-1)}, exp: gomath.Inf(1)},
+	}
+
+	for _, test := range tests {
+		if rec := Nrm2(test.x); !(rec == test.exp || gomath.Abs(rec-test.exp) <= 1e-15*test.exp) {
+			t.Fatalf("\nexpected %v\nreceived %v", test.exp, rec)
+		}
+	}
+}
+
+func TestScal(t *testing.T) {
+	x := Vector{1, 2, 3, 4, 5}
+	dst := Zero(5)
+	if ScalTo(dst, 2, x); !dst.Equal(Vector{2, 4, 6, 8, 10}) {
+		t.Fatalf("\nexpected %v\nreceived %v", Vector{2, 4, 6, 8, 10}, dst)
+	}
+
+	if Scal(-1, x); !x.Equal(Vector{-1, -2, -3, -4, -5}) {
+		t.Fatalf("\nexpected %v\nreceived %v", Vector{-1, -2, -3, -4, -5}, x)
+	}
+}
+
+// benchmarkN is the dimension of vectors in benchmarks.
+const benchmarkN = 1000
+
+func random(r *rand.Rand, n int) Vector {
+	return New(n, func(int) float64 { return r.NormFloat64() })
+}
+
+func BenchmarkAdd(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Add(x, y)
+	}
+}
+
+func BenchmarkAddMethod(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		y.Add(x)
+	}
+}
+
+func BenchmarkArgMax(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.ArgMax()
+	}
+}
+
+func BenchmarkAsum(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Asum(x)
+	}
+}
+
+func BenchmarkAxpy(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Axpy(1, x, y)
+	}
+}
+
+func BenchmarkAxpyTo(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y, dst := random(r, benchmarkN), random(r, benchmarkN), Zero(benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		AxpyTo(dst, 1, x, y)
+	}
+}
+
+func BenchmarkDot(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Dot(y)
+	}
+}
+
+func BenchmarkDotUnitary(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = DotUnitary(x, y)
+	}
+}
+
+func BenchmarkIamax(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Iamax(x)
+	}
+}
+
+func BenchmarkLength(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Length()
+	}
+}
+
+func BenchmarkNrm2(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Nrm2(x)
+	}
+}
+
+func BenchmarkNorm(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Norm(3)
+	}
+}
+
+func BenchmarkNorm1(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Norm1()
+	}
+}
+
+func BenchmarkMultiplyMethod(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Multiply(1)
+	}
+}
+
+func BenchmarkScal(b *testing.B) {
+	x := random(rand.New(rand.NewSource(0)), benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Scal(1, x)
+	}
+}
+
+func BenchmarkSubtractMethod(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		y.Subtract(x)
+	}
+}
+
+func BenchmarkSubtractAxpy(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	x, y := random(r, benchmarkN), random(r, benchmarkN)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Axpy(-1, x, y)
+	}
+}
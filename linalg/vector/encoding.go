@@ -0,0 +1,183 @@
+package vector
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	gomath "math"
+	"strconv"
+
+	"github.com/nathangreene3/math/linalg/internal/codec"
+)
+
+var (
+	// ErrFormat is returned when decoding input that is not in the expected
+	// format.
+	ErrFormat = codec.ErrFormat
+
+	// ErrUnsupported is returned when decoding well-formed input that uses an
+	// unsupported feature, such as complex entries.
+	ErrUnsupported = codec.ErrUnsupported
+)
+
+// ------------------------------------------------------------------------------
+// ENCODING AND DECODING
+// ------------------------------------------------------------------------------
+// Every entry is written with the fewest digits that parse back to the same
+// value, so decoding what was encoded reproduces the vector exactly.
+// ------------------------------------------------------------------------------
+
+// MarshalJSON encodes a vector as a JSON array of numbers. JSON numbers cannot
+// represent NaN or infinities, so those are encoded as the strings "NaN",
+// "+Inf" and "-Inf".
+func (v Vector) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	b := make([]byte, 0, 2+8*len(v))
+	b = append(b, '[')
+	for i, x := range v {
+		if 0 < i {
+			b = append(b, ',')
+		}
+
+		b = appendJSON(b, x)
+	}
+
+	return append(b, ']'), nil
+}
+
+// appendJSON appends the JSON encoding of x to b.
+func appendJSON(b []byte, x float64) []byte {
+	if gomath.IsNaN(x) || gomath.IsInf(x, 0) {
+		return strconv.AppendQuote(b, strconv.FormatFloat(x, 'g', -1, 64))
+	}
+
+	return strconv.AppendFloat(b, x, 'g', -1, 64)
+}
+
+// UnmarshalJSON decodes a JSON array of numbers, or of the strings "NaN",
+// "+Inf" and "-Inf", into a vector.
+func (v *Vector) UnmarshalJSON(b []byte) error {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return err
+	}
+
+	if entries == nil {
+		*v = nil
+		return nil
+	}
+
+	w := make(Vector, 0, len(entries))
+	for _, e := range entries {
+		s := string(e)
+		if 0 < len(s) && s[0] == '"' {
+			if err := json.Unmarshal(e, &s); err != nil {
+				return err
+			}
+		}
+
+		x, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid vector entry %s: %w", e, ErrFormat)
+		}
+
+		w = append(w, x)
+	}
+
+	*v = w
+	return nil
+}
+
+// ReadCSV reads a vector from CSV, given either as a single column or as a
+// single row.
+func ReadCSV(r io.Reader) (Vector, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	switch {
+	case len(records) == 0:
+	case len(records) == 1:
+		fields = records[0]
+	case len(records[0]) == 1:
+		for _, record := range records {
+			fields = append(fields, record[0])
+		}
+	default:
+		return nil, fmt.Errorf("csv has %d rows and %d columns: %w", len(records), len(records[0]), ErrDimensionMismatch)
+	}
+
+	v := make(Vector, 0, len(fields))
+	for _, f := range fields {
+		x, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector entry %q: %w", f, ErrFormat)
+		}
+
+		v = append(v, x)
+	}
+
+	return v, nil
+}
+
+// WriteCSV writes a vector as CSV with one entry per line.
+func (v Vector) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	for _, x := range v {
+		cw.Write([]string{strconv.FormatFloat(x, 'g', -1, 64)})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadMatrixMarket reads a vector stored as a single column or single row
+// matrix in the MatrixMarket array or coordinate format.
+func ReadMatrixMarket(r io.Reader) (Vector, error) {
+	m, n, data, err := codec.ReadMatrixMarket(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != 1 && n != 1 {
+		return nil, fmt.Errorf("matrix market matrix is %d-by-%d: %w", m, n, ErrDimensionMismatch)
+	}
+
+	return Vector(data), nil
+}
+
+// WriteMatrixMarket writes a vector as a single column matrix in the
+// MatrixMarket format. The array format lists every entry, while the
+// coordinate format lists only the non-zero entries.
+func (v Vector) WriteMatrixMarket(w io.Writer, coordinate bool) error {
+	return codec.WriteMatrixMarket(w, len(v), 1, v, coordinate)
+}
+
+// ReadNPY reads a vector from a NumPy .npy file holding a one-dimensional
+// array, or a two-dimensional array with a single row or column.
+func ReadNPY(r io.Reader) (Vector, error) {
+	shape, data, err := codec.ReadNPY(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if 2 < len(shape) || len(shape) == 2 && shape[0] != 1 && shape[1] != 1 {
+		return nil, fmt.Errorf("npy array has shape %v: %w", shape, ErrDimensionMismatch)
+	}
+
+	return Vector(data), nil
+}
+
+// WriteNPY writes a vector as a one-dimensional float64 array in the NumPy .npy
+// format.
+func (v Vector) WriteNPY(w io.Writer) error {
+	return codec.WriteNPY(w, []int{len(v)}, v)
+}
@@ -0,0 +1,131 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	gomath "math"
+	"strings"
+	"testing"
+)
+
+// identical returns true if two vectors have the same entries, bit for bit.
+func identical(v, w Vector) bool {
+	if len(v) != len(w) {
+		return false
+	}
+
+	for i := range v {
+		if gomath.Float64bits(v[i]) != gomath.Float64bits(w[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestEncoding(t *testing.T) {
+	var (
+		v = Vector{0.1, -2, 1e-310, gomath.MaxFloat64, gomath.Copysign(0, -1), gomath.Pi}
+		w = Vector{gomath.Inf(1), gomath.Inf(-1), 3}
+	)
+
+	tests := []struct {
+		name   string
+		encode func(Vector, *bytes.Buffer) error
+		decode func(*bytes.Buffer) (Vector, error)
+	}{
+		{
+			name: "json",
+			encode: func(v Vector, b *bytes.Buffer) error {
+				return json.NewEncoder(b).Encode(v)
+			},
+			decode: func(b *bytes.Buffer) (Vector, error) {
+				var v Vector
+				err := json.NewDecoder(b).Decode(&v)
+				return v, err
+			},
+		},
+		{
+			name:   "csv",
+			encode: func(v Vector, b *bytes.Buffer) error { return v.WriteCSV(b) },
+			decode: func(b *bytes.Buffer) (Vector, error) { return ReadCSV(b) },
+		},
+		{
+			name:   "matrix market array",
+			encode: func(v Vector, b *bytes.Buffer) error { return v.WriteMatrixMarket(b, false) },
+			decode: func(b *bytes.Buffer) (Vector, error) { return ReadMatrixMarket(b) },
+		},
+		{
+			name:   "matrix market coordinate",
+			encode: func(v Vector, b *bytes.Buffer) error { return v.WriteMatrixMarket(b, true) },
+			decode: func(b *bytes.Buffer) (Vector, error) { return ReadMatrixMarket(b) },
+		},
+		{
+			name:   "npy",
+			encode: func(v Vector, b *bytes.Buffer) error { return v.WriteNPY(b) },
+			decode: func(b *bytes.Buffer) (Vector, error) { return ReadNPY(b) },
+		},
+	}
+
+	for _, test := range tests {
+		for _, exp := range []Vector{v, w} {
+			var b bytes.Buffer
+			if err := test.encode(exp, &b); err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			rec, err := test.decode(&b)
+			if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			if !identical(exp, rec) {
+				t.Fatalf("%s\nexpected %v\nreceived %v", test.name, exp, rec)
+			}
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	exp := `[1,-0.5,"NaN","+Inf"]`
+	b, err := json.Marshal(Vector{1, -0.5, gomath.NaN(), gomath.Inf(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rec := string(b); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	var v Vector
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(v) != 4 || !gomath.IsNaN(v[2]) || !gomath.IsInf(v[3], 1) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, v)
+	}
+
+	if err := json.Unmarshal([]byte(`[1,"one"]`), &v); !errors.Is(err, ErrFormat) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrFormat, err)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	exp := Vector{1, 2, 3}
+	for _, input := range []string{"1\n2\n3\n", "1, 2, 3\n"} {
+		rec, err := ReadCSV(strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !exp.Equal(rec) {
+			t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+		}
+	}
+
+	if _, err := ReadCSV(strings.NewReader("1,2\n3,4\n")); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+}
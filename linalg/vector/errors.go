@@ -0,0 +1,165 @@
+package vector
+
+import "errors"
+
+// ErrDimensionMismatch is returned when two vectors (or a vector and a matrix)
+// do not have compatible dimensions.
+var ErrDimensionMismatch = errors.New("dimension mismatch")
+
+// ErrZeroLength is returned when a vector of zero length has no direction.
+var ErrZeroLength = errors.New("vector has zero length")
+
+// ------------------------------------------------------------------------------
+// ERROR-RETURNING OPERATIONS ON VECTORS
+// ------------------------------------------------------------------------------
+// Each TryF returns an error where F would panic. The arguments are unchanged
+// when an error is returned.
+// ------------------------------------------------------------------------------
+
+// TryAdd returns v+w.
+func TryAdd(v, w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrDimensionMismatch
+	}
+
+	return Add(v, w), nil
+}
+
+// TryAdd adds w to v.
+func (v Vector) TryAdd(w Vector) error {
+	if len(v) != len(w) {
+		return ErrDimensionMismatch
+	}
+
+	v.Add(w)
+	return nil
+}
+
+// TryChebyshevDistance returns the largest absolute difference between the
+// entries of v and w.
+func (v Vector) TryChebyshevDistance(w Vector) (float64, error) {
+	if len(v) != len(w) {
+		return 0, ErrDimensionMismatch
+	}
+
+	return v.ChebyshevDistance(w), nil
+}
+
+// TryCosineDistance returns one minus the cosine of the angle between v and w.
+// If either vector has zero length, ErrZeroLength is returned.
+func (v Vector) TryCosineDistance(w Vector) (float64, error) {
+	switch {
+	case len(v) != len(w):
+		return 0, ErrDimensionMismatch
+	case v.NormInf() == 0 || w.NormInf() == 0:
+		return 0, ErrZeroLength
+	}
+
+	return v.CosineDistance(w), nil
+}
+
+// TryCross returns the cross product v x w of two three-dimensional vectors.
+func TryCross(v, w Vector) (Vector, error) {
+	if len(v) != 3 || len(w) != 3 {
+		return nil, ErrDimensionMismatch
+	}
+
+	return Cross(v, w), nil
+}
+
+// TryDot returns v dot w.
+func (v Vector) TryDot(w Vector) (float64, error) {
+	if len(v) != len(w) {
+		return 0, ErrDimensionMismatch
+	}
+
+	return v.Dot(w), nil
+}
+
+// TryEuclideanDistance returns the length of v-w.
+func (v Vector) TryEuclideanDistance(w Vector) (float64, error) {
+	if len(v) != len(w) {
+		return 0, ErrDimensionMismatch
+	}
+
+	return v.EuclideanDistance(w), nil
+}
+
+// TryHadamard returns the entry-wise product of two vectors.
+func TryHadamard(v, w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrDimensionMismatch
+	}
+
+	return Hadamard(v, w), nil
+}
+
+// TryHadamard multiplies each entry of v by the corresponding entry of w.
+func (v Vector) TryHadamard(w Vector) error {
+	if len(v) != len(w) {
+		return ErrDimensionMismatch
+	}
+
+	v.Hadamard(w)
+	return nil
+}
+
+// TryHadamardDivide returns the entry-wise quotient of two vectors.
+func TryHadamardDivide(v, w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrDimensionMismatch
+	}
+
+	return HadamardDivide(v, w), nil
+}
+
+// TryHadamardDivide divides each entry of v by the corresponding entry of w.
+func (v Vector) TryHadamardDivide(w Vector) error {
+	if len(v) != len(w) {
+		return ErrDimensionMismatch
+	}
+
+	v.HadamardDivide(w)
+	return nil
+}
+
+// TryManhattanDistance returns the sum of the absolute differences between the
+// entries of v and w.
+func (v Vector) TryManhattanDistance(w Vector) (float64, error) {
+	if len(v) != len(w) {
+		return 0, ErrDimensionMismatch
+	}
+
+	return v.ManhattanDistance(w), nil
+}
+
+// TrySubtract returns v-w.
+func TrySubtract(v, w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrDimensionMismatch
+	}
+
+	return Subtract(v, w), nil
+}
+
+// TrySubtract subtracts w from v.
+func (v Vector) TrySubtract(w Vector) error {
+	if len(v) != len(w) {
+		return ErrDimensionMismatch
+	}
+
+	v.Subtract(w)
+	return nil
+}
+
+// TryTripleProduct returns the signed volume of the parallelotope spanned by n
+// vectors of dimension n.
+func TryTripleProduct(vs ...Vector) (float64, error) {
+	for _, v := range vs {
+		if len(v) != len(vs) {
+			return 0, ErrDimensionMismatch
+		}
+	}
+
+	return TripleProduct(vs...), nil
+}
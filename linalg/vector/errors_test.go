@@ -0,0 +1,84 @@
+package vector
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTry(t *testing.T) {
+	var (
+		v = Vector{1, 2, 3}
+		w = Vector{4, 5, 6}
+		u = Vector{1, 2}
+	)
+
+	if x, err := TryAdd(v, w); err != nil || !x.Equal(Vector{5, 7, 9}) {
+		t.Fatalf("\nexpected [5 7 9]\nreceived %v (%v)", x, err)
+	}
+
+	if d, err := v.TryDot(w); err != nil || d != 32 {
+		t.Fatalf("\nexpected 32\nreceived %v (%v)", d, err)
+	}
+
+	if _, err := TryAdd(v, u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := TrySubtract(v, u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := v.TryDot(u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if err := v.TryAdd(u); !errors.Is(err, ErrDimensionMismatch) || !v.Equal(Vector{1, 2, 3}) {
+		t.Fatalf("\nexpected %v and unchanged %v\nreceived %v", ErrDimensionMismatch, Vector{1, 2, 3}, err)
+	}
+
+	if err := v.TrySubtract(u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+}
+
+func TestTrySpace(t *testing.T) {
+	var (
+		v = Vector{1, 2, 3}
+		u = Vector{1, 2}
+	)
+
+	if _, err := TryCross(u, u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := TryTripleProduct(v, v); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := TryHadamard(v, u); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+	}
+
+	if err := v.TryHadamardDivide(u); !errors.Is(err, ErrDimensionMismatch) || !v.Equal(Vector{1, 2, 3}) {
+		t.Fatalf("\nexpected %v and unchanged %v\nreceived %v", ErrDimensionMismatch, Vector{1, 2, 3}, err)
+	}
+
+	for _, f := range []func(Vector) (float64, error){
+		v.TryChebyshevDistance,
+		v.TryCosineDistance,
+		v.TryEuclideanDistance,
+		v.TryManhattanDistance,
+	} {
+		if _, err := f(u); !errors.Is(err, ErrDimensionMismatch) {
+			t.Fatalf("\nexpected %v\nreceived %v", ErrDimensionMismatch, err)
+		}
+	}
+
+	if _, err := v.TryCosineDistance(Zero(3)); !errors.Is(err, ErrZeroLength) {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrZeroLength, err)
+	}
+
+	if d, err := v.TryManhattanDistance(Zero(3)); err != nil || d != 6 {
+		t.Fatalf("\nexpected 6\nreceived %v (%v)", d, err)
+	}
+}
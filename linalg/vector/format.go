@@ -0,0 +1,64 @@
+package vector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nathangreene3/math/linalg/internal/format"
+)
+
+var (
+	// FormatThreshold is the number of entries above which a vector is
+	// elided when formatted with Format.
+	FormatThreshold = 1000
+
+	// FormatEdgeItems is the number of entries shown at each end of an elided
+	// vector.
+	FormatEdgeItems = 3
+)
+
+// Format implements fmt.Formatter. The verbs f, F, e, E, g and G format each
+// entry as strconv.FormatFloat does, using the precision if one is given and
+// the fewest digits that represent the entry exactly otherwise. The verbs v and
+// s are the same as f, so fmt.Sprint(v) and v.String() agree unless v is
+// elided. For example, fmt.Sprintf("%.1f", v) returns "[1.0 -2.5 3.0]".
+//
+// Each entry is padded to the width, if one is given, and the flags are
+//
+//	'+'	always print the sign of each entry
+//	'-'	pad entries on the right rather than the left
+//	'#'	print a LaTeX bmatrix column vector
+//
+// A vector of more than FormatThreshold entries is elided, showing only the
+// first and last FormatEdgeItems entries, as NumPy does.
+func (v Vector) Format(f fmt.State, verb rune) {
+	spec, ok := format.Verb(verb)
+	if !ok {
+		fmt.Fprintf(f, "%%!%c(vector.Vector=%s)", verb, v.String())
+		return
+	}
+
+	var (
+		latex    = f.Flag('#')
+		width, _ = f.Width()
+		entries  = make([]string, 0, len(v))
+	)
+
+	for _, i := range format.Elide(len(v), len(v), FormatThreshold, FormatEdgeItems) {
+		switch {
+		case i >= 0:
+			entries = append(entries, format.Pad(format.Entry(f, spec, v[i]), width, f.Flag('-')))
+		case latex:
+			entries = append(entries, `\vdots`)
+		default:
+			entries = append(entries, "...")
+		}
+	}
+
+	if latex {
+		fmt.Fprintf(f, "\\begin{bmatrix}\n%s\n\\end{bmatrix}", strings.Join(entries, ` \\`+"\n"))
+		return
+	}
+
+	fmt.Fprintf(f, "[%s]", strings.Join(entries, " "))
+}
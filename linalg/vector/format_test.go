@@ -0,0 +1,45 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatter(t *testing.T) {
+	var (
+		v     = Vector{1, -2.5, 3}
+		long  = New(FormatThreshold+1, func(i int) float64 { return float64(i) })
+		tests = []struct {
+			format string
+			v      Vector
+			exp    string
+		}{
+			{format: "%v", v: v, exp: "[1 -2.5 3]"},
+			{format: "%.3f", v: v, exp: "[1.000 -2.500 3.000]"},
+			{format: "%+v", v: v, exp: "[+1 -2.5 +3]"},
+			{format: "%6.2f", v: v, exp: "[  1.00  -2.50   3.00]"},
+			{format: "%-4v|", v: v, exp: "[1    -2.5 3   ]|"},
+			{format: "%.1e", v: v, exp: "[1.0e+00 -2.5e+00 3.0e+00]"},
+			{format: "%#v", v: v, exp: "\\begin{bmatrix}\n1 \\\\\n-2.5 \\\\\n3\n\\end{bmatrix}"},
+			{format: "%d", v: v, exp: "%!d(vector.Vector=[1 -2.5 3])"},
+			{format: "%v", v: Vector{}, exp: "[]"},
+			{format: "%v", v: Vector{1e21, 1e-7}, exp: "[1000000000000000000000 0.0000001]"},
+			{format: "%g", v: Vector{1e21, 1e-7}, exp: "[1e+21 1e-07]"},
+			{format: "%v", v: long, exp: "[0 1 2 ... 998 999 1000]"},
+			{format: "%#v", v: long, exp: "\\begin{bmatrix}\n0 \\\\\n1 \\\\\n2 \\\\\n\\vdots \\\\\n998 \\\\\n999 \\\\\n1000\n\\end{bmatrix}"},
+		}
+	)
+
+	for _, test := range tests {
+		if rec := fmt.Sprintf(test.format, test.v); test.exp != rec {
+			t.Fatalf("\nexpected %q\nreceived %q", test.exp, rec)
+		}
+	}
+
+	// Printing a vector gives the same result as String.
+	for _, v := range []Vector{v, {1e21, -1e-7}, {}} {
+		if exp, rec := v.String(), fmt.Sprint(v); exp != rec {
+			t.Fatalf("\nexpected %q\nreceived %q", exp, rec)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package vector
+
+// ------------------------------------------------------------------------------
+// GENERIC VECTORS
+// ------------------------------------------------------------------------------
+// Of[T] provides the arithmetic of Vector for any numeric entry type. Vector
+// has the same underlying type as Of[float64], so either converts to the other
+// without copying, as in Of[float64](v) and Vector(w). Operations that need
+// square roots or ordering, such as Length and Compare, remain on Vector.
+// ------------------------------------------------------------------------------
+
+// Number is the set of types a generic vector or matrix may hold.
+type Number interface {
+	Real | ~complex64 | ~complex128
+}
+
+// Float is the set of floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Signed is the set of signed integer and floating-point types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | Float
+}
+
+// Real is the set of integer and floating-point types.
+type Real interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Of is a vector with entries of type T.
+type Of[T Number] []T
+
+// NewOf generates a vector of dimension n with entries defined by a generating
+// function f.
+func NewOf[T Number](n int, f func(i int) T) Of[T] {
+	v := make(Of[T], 0, n)
+	for i := 0; i < n; i++ {
+		v = append(v, f(i))
+	}
+
+	return v
+}
+
+// ZeroOf returns the zero vector of n dimensions.
+func ZeroOf[T Number](n int) Of[T] {
+	return make(Of[T], n)
+}
+
+// AddOf returns v+w.
+func AddOf[T Number](v, w Of[T]) Of[T] {
+	u := v.Copy()
+	u.Add(w)
+	return u
+}
+
+// Add w to v.
+func (v Of[T]) Add(w Of[T]) {
+	if len(v) != len(w) {
+		panic("dimension mismatch")
+	}
+
+	for i := range v {
+		v[i] += w[i]
+	}
+}
+
+// Convert returns a vector with the entries of v converted to type U, as by
+// the conversion U(v[i]).
+func Convert[U, T Real](v Of[T]) Of[U] {
+	return NewOf(len(v), func(i int) U { return U(v[i]) })
+}
+
+// Copy a vector.
+func (v Of[T]) Copy() Of[T] {
+	w := make(Of[T], len(v))
+	copy(w, v)
+	return w
+}
+
+// Dimensions returns len(v).
+func (v Of[T]) Dimensions() int {
+	return len(v)
+}
+
+// Dot returns the sum of v[i]*w[i]. Complex entries are not conjugated.
+func (v Of[T]) Dot(w Of[T]) T {
+	if len(v) != len(w) {
+		panic("dimension mismatch")
+	}
+
+	var s T
+	for i := range v {
+		s += v[i] * w[i]
+	}
+
+	return s
+}
+
+// Equal returns true if v and w have the same dimension and entries.
+func (v Of[T]) Equal(w Of[T]) bool {
+	if len(v) != len(w) {
+		return false
+	}
+
+	for i := range v {
+		if v[i] != w[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MultiplyOf returns av.
+func MultiplyOf[T Number](a T, v Of[T]) Of[T] {
+	w := v.Copy()
+	w.Multiply(a)
+	return w
+}
+
+// Multiply each value by a.
+func (v Of[T]) Multiply(a T) {
+	for i := range v {
+		v[i] *= a
+	}
+}
+
+// SubtractOf returns v-w.
+func SubtractOf[T Number](v, w Of[T]) Of[T] {
+	u := v.Copy()
+	u.Subtract(w)
+	return u
+}
+
+// Subtract w from v.
+func (v Of[T]) Subtract(w Of[T]) {
+	if len(v) != len(w) {
+		panic("dimension mismatch")
+	}
+
+	for i := range v {
+		v[i] -= w[i]
+	}
+}
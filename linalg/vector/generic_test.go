@@ -0,0 +1,39 @@
+package vector
+
+import "testing"
+
+func TestOf(t *testing.T) {
+	var (
+		v = Of[int]{1, 2, 3}
+		w = Of[int]{4, 5, 6}
+	)
+
+	if exp, rec := (Of[int]{5, 7, 9}), AddOf(v, w); !exp.Equal(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := (Of[int]{-3, -3, -3}), SubtractOf(v, w); !exp.Equal(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := 32, v.Dot(w); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// (1+i)(1-i) + 2i*2i = 2 - 4
+	c := Of[complex128]{1 + 1i, 2i}
+	if exp, rec := complex128(-2), c.Dot(Of[complex128]{1 - 1i, 2i}); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := (Of[float32]{0.5, 1, 1.5}), MultiplyOf(0.5, Convert[float32](v)); !exp.Equal(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	// Vector and Of[float64] share an underlying type.
+	x := Vector{1, 2}
+	Of[float64](x).Multiply(2)
+	if exp := (Vector{2, 4}); !exp.Equal(x) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, x)
+	}
+}
@@ -0,0 +1,248 @@
+package vector
+
+import gomath "math"
+
+// ------------------------------------------------------------------------------
+// PRODUCTS
+// ------------------------------------------------------------------------------
+
+// Cross returns the cross product v x w of two three-dimensional vectors.
+func Cross(v, w Vector) Vector {
+	if len(v) != 3 || len(w) != 3 {
+		panic("vectors must be three-dimensional")
+	}
+
+	return Vector{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}
+}
+
+// Hadamard returns the entry-wise product of two vectors.
+func Hadamard(v, w Vector) Vector {
+	u := v.Copy()
+	u.Hadamard(w)
+	return u
+}
+
+// Hadamard multiplies each entry of v by the corresponding entry of w.
+func (v Vector) Hadamard(w Vector) {
+	n := len(v)
+	if n != len(w) {
+		panic("dimension mismatch")
+	}
+
+	for i := 0; i < n; i++ {
+		v[i] *= w[i]
+	}
+}
+
+// HadamardDivide returns the entry-wise quotient of two vectors.
+func HadamardDivide(v, w Vector) Vector {
+	u := v.Copy()
+	u.HadamardDivide(w)
+	return u
+}
+
+// HadamardDivide divides each entry of v by the corresponding entry of w.
+func (v Vector) HadamardDivide(w Vector) {
+	n := len(v)
+	if n != len(w) {
+		panic("dimension mismatch")
+	}
+
+	for i := 0; i < n; i++ {
+		v[i] /= w[i]
+	}
+}
+
+// TripleProduct returns the signed volume of the parallelotope spanned by n
+// vectors of dimension n, which is the determinant of the matrix having them
+// as rows. For three vectors, this is the scalar triple product u dot (v x w).
+func TripleProduct(vs ...Vector) float64 {
+	n := len(vs)
+	A := make([]Vector, 0, n)
+	for _, v := range vs {
+		if len(v) != n {
+			panic("dimension mismatch")
+		}
+
+		A = append(A, v.Copy())
+	}
+
+	// Gaussian elimination with partial pivoting reduces A to upper
+	// triangular form, negating the determinant at each row swap.
+	det := 1.0
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if gomath.Abs(A[p][k]) < gomath.Abs(A[i][k]) {
+				p = i
+			}
+		}
+
+		if A[p][k] == 0 {
+			return 0
+		}
+
+		if p != k {
+			A[p], A[k] = A[k], A[p]
+			det = -det
+		}
+
+		det *= A[k][k]
+		for i := k + 1; i < n; i++ {
+			f := A[i][k] / A[k][k]
+			for j := k + 1; j < n; j++ {
+				A[i][j] -= f * A[k][j]
+			}
+		}
+	}
+
+	return det
+}
+
+// ------------------------------------------------------------------------------
+// NORMS AND DISTANCES
+// ------------------------------------------------------------------------------
+
+// ChebyshevDistance returns the largest absolute difference between the entries
+// of v and w.
+func (v Vector) ChebyshevDistance(w Vector) float64 {
+	return Subtract(v, w).NormInf()
+}
+
+// CosineDistance returns one minus the cosine of the angle between v and w. It
+// is zero for vectors pointing the same way, one for orthogonal vectors, and
+// two for vectors pointing in opposite directions. It is NaN if either vector
+// has zero length.
+func (v Vector) CosineDistance(w Vector) float64 {
+	return 1 - v.Unit().Dot(w.Unit())
+}
+
+// EuclideanDistance returns the length of v-w.
+func (v Vector) EuclideanDistance(w Vector) float64 {
+	return Nrm2(Subtract(v, w))
+}
+
+// ManhattanDistance returns the sum of the absolute differences between the
+// entries of v and w.
+func (v Vector) ManhattanDistance(w Vector) float64 {
+	return Asum(Subtract(v, w))
+}
+
+// Norm returns the Lp norm (sum |v[i]|^p)^(1/p) for p on the range [1,+Inf].
+// For p = +Inf, it is the largest absolute entry. The sum is scaled by the
+// largest absolute entry, so it does not overflow unless the result does. The
+// common cases p = 1 and p = 2 are computed by Asum and Nrm2.
+func (v Vector) Norm(p float64) float64 {
+	switch {
+	case !(1 <= p):
+		panic("p must be at least one")
+	case p == 1:
+		return Asum(v)
+	case p == 2:
+		return Nrm2(v)
+	case gomath.IsInf(p, 1):
+		return v.NormInf()
+	}
+
+	scale := v.NormInf()
+	if scale == 0 || gomath.IsInf(scale, 1) {
+		return scale
+	}
+
+	var s float64
+	for _, a := range v {
+		s += gomath.Pow(gomath.Abs(a)/scale, p)
+	}
+
+	return scale * gomath.Pow(s, 1/p)
+}
+
+// Norm1 returns the sum of the absolute entries of v. See Asum.
+func (v Vector) Norm1() float64 {
+	return Asum(v)
+}
+
+// NormInf returns the largest absolute entry of v, or zero if v has no
+// entries.
+func (v Vector) NormInf() float64 {
+	var max float64
+	for _, a := range v {
+		if a = gomath.Abs(a); max < a || gomath.IsNaN(a) {
+			max = a
+		}
+	}
+
+	return max
+}
+
+// Normalize scales v to length one. If v has zero length, ErrZeroLength is
+// returned and v is unchanged.
+func (v Vector) Normalize() error {
+	r := Nrm2(v)
+	if r == 0 {
+		return ErrZeroLength
+	}
+
+	v.Divide(r)
+	return nil
+}
+
+// ------------------------------------------------------------------------------
+// REDUCTIONS
+// ------------------------------------------------------------------------------
+
+// ArgMax returns the index of the first largest entry of v.
+func (v Vector) ArgMax() int {
+	if len(v) == 0 {
+		panic("vector must have at least one entry")
+	}
+
+	k := 0
+	for i := 1; i < len(v); i++ {
+		if v[k] < v[i] {
+			k = i
+		}
+	}
+
+	return k
+}
+
+// ArgMin returns the index of the first smallest entry of v.
+func (v Vector) ArgMin() int {
+	if len(v) == 0 {
+		panic("vector must have at least one entry")
+	}
+
+	k := 0
+	for i := 1; i < len(v); i++ {
+		if v[i] < v[k] {
+			k = i
+		}
+	}
+
+	return k
+}
+
+// Max returns the largest entry of v.
+func (v Vector) Max() float64 {
+	return v[v.ArgMax()]
+}
+
+// Min returns the smallest entry of v.
+func (v Vector) Min() float64 {
+	return v[v.ArgMin()]
+}
+
+// Sum returns the sum of the entries of v.
+func (v Vector) Sum() float64 {
+	var s float64
+	for _, a := range v {
+		s += a
+	}
+
+	return s
+}
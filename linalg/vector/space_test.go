@@ -0,0 +1,160 @@
+package vector
+
+import (
+	gomath "math"
+	"testing"
+)
+
+func TestCross(t *testing.T) {
+	var (
+		v   = Vector{1, 0, 0}
+		w   = Vector{0, 1, 0}
+		exp = Vector{0, 0, 1}
+	)
+
+	if rec := Cross(v, w); !rec.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec := Cross(w, v); !rec.Equal(Multiply(-1, exp)) {
+		t.Fatalf("\nexpected %v\nreceived %v", Multiply(-1, exp), rec)
+	}
+
+	u := Vector{2, -3, 5}
+	if rec := Cross(u, Vector{-1, 4, 7}); rec.Dot(u) != 0 {
+		t.Fatalf("\nexpected %v orthogonal to %v", rec, u)
+	}
+}
+
+func TestDistances(t *testing.T) {
+	var (
+		v = Vector{1, 2, 3}
+		w = Vector{4, 6, 3}
+	)
+
+	tests := []struct {
+		name     string
+		rec, exp float64
+	}{
+		{name: "Euclidean", rec: v.EuclideanDistance(w), exp: 5},
+		{name: "Manhattan", rec: v.ManhattanDistance(w), exp: 7},
+		{name: "Chebyshev", rec: v.ChebyshevDistance(w), exp: 4},
+		{name: "cosine, parallel", rec: v.CosineDistance(Vector{2, 4, 6}), exp: 0},
+		{name: "cosine, orthogonal", rec: Vector{1, 0}.CosineDistance(Vector{0, 3}), exp: 1},
+		{name: "cosine, opposite", rec: Vector{1, 1}.CosineDistance(Vector{-2, -2}), exp: 2},
+	}
+
+	for _, test := range tests {
+		if 1e-12 < gomath.Abs(test.rec-test.exp) {
+			t.Fatalf("\n%s: expected %v\nreceived %v", test.name, test.exp, test.rec)
+		}
+	}
+}
+
+func TestHadamard(t *testing.T) {
+	var (
+		v = Vector{1, 2, 3}
+		w = Vector{4, 5, 6}
+	)
+
+	if exp, rec := (Vector{4, 10, 18}), Hadamard(v, w); !rec.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp, rec := (Vector{0.25, 0.4, 0.5}), HadamardDivide(v, w); !rec.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if exp := (Vector{1, 2, 3}); !v.Equal(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, v)
+	}
+}
+
+func TestNorm(t *testing.T) {
+	v := Vector{3, -4}
+	tests := []struct {
+		p, exp float64
+	}{
+		{p: 1, exp: 7},
+		{p: 2, exp: 5},
+		{p: 3, exp: gomath.Cbrt(91)},
+		{p: gomath.Inf(1), exp: 4},
+	}
+
+	for _, test := range tests {
+		if rec := v.Norm(test.p); 1e-12 < gomath.Abs(rec-test.exp) {
+			t.Fatalf("\nexpected %v\nreceived %v for p = %v", test.exp, rec, test.p)
+		}
+	}
+
+	// Squaring these entries overflows.
+	if exp, rec := 5e300, (Vector{3e300, 4e300}).Norm(2); 1e288 < gomath.Abs(rec-exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec := Zero(3).Norm(2); rec != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", 0, rec)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	v := Vector{3, 4}
+	if err := v.Normalize(); err != nil {
+		t.Fatal(err)
+	}
+
+	if exp := (Vector{0.6, 0.8}); !v.Approx(exp, 1e-12) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, v)
+	}
+
+	if err := Zero(2).Normalize(); err != ErrZeroLength {
+		t.Fatalf("\nexpected %v\nreceived %v", ErrZeroLength, err)
+	}
+}
+
+func TestReductions(t *testing.T) {
+	v := Vector{2, -1, 7, 7, -3}
+	if rec := v.Sum(); rec != 12 {
+		t.Fatalf("\nexpected %v\nreceived %v", 12, rec)
+	}
+
+	if rec := v.Max(); rec != 7 {
+		t.Fatalf("\nexpected %v\nreceived %v", 7, rec)
+	}
+
+	if rec := v.Min(); rec != -3 {
+		t.Fatalf("\nexpected %v\nreceived %v", -3, rec)
+	}
+
+	if rec := v.ArgMax(); rec != 2 {
+		t.Fatalf("\nexpected %v\nreceived %v", 2, rec)
+	}
+
+	if rec := v.ArgMin(); rec != 4 {
+		t.Fatalf("\nexpected %v\nreceived %v", 4, rec)
+	}
+}
+
+func TestTripleProduct(t *testing.T) {
+	var (
+		u = Vector{1, 2, 3}
+		v = Vector{0, 1, 4}
+		w = Vector{5, 6, 0}
+	)
+
+	if exp, rec := u.Dot(Cross(v, w)), TripleProduct(u, v, w); 1e-12 < gomath.Abs(rec-exp) {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
+
+	if rec := TripleProduct(v, u, w); 1e-12 < gomath.Abs(rec-(-u.Dot(Cross(v, w)))) {
+		t.Fatalf("\nexpected %v\nreceived %v", -u.Dot(Cross(v, w)), rec)
+	}
+
+	if rec := TripleProduct(Vector{1, 2}, Vector{2, 4}); rec != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v", 0, rec)
+	}
+
+	if rec := TripleProduct(Vector{0, 2}, Vector{3, 0}); rec != -6 {
+		t.Fatalf("\nexpected %v\nreceived %v", -6, rec)
+	}
+}
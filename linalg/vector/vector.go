@@ -168,8 +168,11 @@ func (v Vector) Equal(w Vector) bool {
 	return v.Compare(w) == 0
 }
 
-// Format a vector as a string. TODO: Finish documentation.
-func (v Vector) Format(fmt byte, prec int, left, right, sep rune) string {
+// FormatFloat returns a vector as a string, with each entry formatted as by
+// strconv.FormatFloat(x, fmt, prec, 64), separated by sep, and enclosed by left
+// and right. It was named Format until Vector implemented fmt.Formatter, so
+// callers of v.Format(fmt, prec, left, right, sep) should call FormatFloat.
+func (v Vector) FormatFloat(fmt byte, prec int, left, right, sep rune) string {
 	var sb strings.Builder
 	sb.WriteRune(left)
 	if n := len(v); 0 < n {
@@ -225,9 +228,9 @@ func (v Vector) IsMultipleOf(w Vector) bool {
 	return true
 }
 
-// Length returns |v|. This is NOT len(v).
+// Length returns |v|. This is NOT len(v). See Nrm2.
 func (v Vector) Length() float64 {
-	return gomath.Sqrt(v.Dot(v))
+	return Nrm2(v)
 }
 
 // Multiply returns av.
@@ -244,7 +247,7 @@ func (v Vector) Multiply(a float64) {
 
 // String returns the default string-representation of a vector.
 func (v Vector) String() string {
-	return v.Format('f', -1, '[', ']', ' ')
+	return v.FormatFloat('f', -1, '[', ']', ' ')
 }
 
 // Subtract returns v-w.
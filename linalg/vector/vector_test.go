@@ -2,10 +2,11 @@ package vector
 
 import "testing"
 
-// TODO
-func TestFormat(t *testing.T) {
-	// <1.000e+00,2.000e+00,3.000e+00>
-	// t.Fatalf("%s\n", New(3, func(i int) float64 { return float64(i + 1) }).Format('e', 3, '<', '>', ','))
+func TestFormatFloat(t *testing.T) {
+	exp := "<1.000e+00,2.000e+00,3.000e+00>"
+	if rec := New(3, func(i int) float64 { return float64(i + 1) }).FormatFloat('e', 3, '<', '>', ','); exp != rec {
+		t.Fatalf("\nexpected %v\nreceived %v", exp, rec)
+	}
 }
 
 func TestIsMultipleOf(t *testing.T) {